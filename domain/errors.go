@@ -10,6 +10,7 @@ var (
 	ErrIsDuplicate = errors.New("is duplicate")
 	ErrIsEmpty     = errors.New("is empty")
 	ErrNotFound    = errors.New("not found")
+	ErrInvalidUTF8 = errors.New("contains invalid utf-8")
 )
 
 type MultiError interface {
@@ -28,6 +29,11 @@ type multiErr struct {
 }
 
 func (m *multiErr) Error() string {
+	return m.format(func(err error) string { return err.Error() })
+}
+
+// format returns the message of the multi error, with the message of each of its errors produced by the provided func
+func (m *multiErr) format(msgFn func(err error) string) string {
 	var msg string
 	switch len(m.Errs) {
 	case 0:
@@ -40,7 +46,7 @@ func (m *multiErr) Error() string {
 
 	for _, err := range m.Errs {
 		if err != nil && err.Error() != "" {
-			msg += fmt.Sprintf("- %s\n", err.Error())
+			msg += fmt.Sprintf("- %s\n", msgFn(err))
 		}
 	}
 
@@ -80,3 +86,74 @@ func (m *multiErrWithPrefix) Add(err error) {
 func (m *multiErrWithPrefix) WithPrefix(prefix string) MultiError {
 	return m.MultiError.WithPrefix(m.prefix + ": " + prefix)
 }
+
+// ValidationOptions customises the messages of the errors that are returned by a loader
+//
+// The zero value reproduces the default messages
+type ValidationOptions struct {
+	// Prefix is prepended to the message of each error
+	Prefix string
+	// IsEmptyLabel replaces the message of ErrIsEmpty
+	IsEmptyLabel string
+	// NotFoundLabel replaces the message of ErrNotFound
+	NotFoundLabel string
+}
+
+// apply returns the provided error with its message customised by the options
+func (v ValidationOptions) apply(err error) error {
+	if err == nil || v == (ValidationOptions{}) {
+		return err
+	}
+
+	msg := v.message(err)
+	if v.Prefix != "" {
+		msg = v.Prefix + ": " + msg
+	}
+
+	return &validationErr{err: err, msg: msg}
+}
+
+// message builds the message of the provided error from the chain of errors that it wraps, so that each sentinel error that has a
+// label contributes its label instead of its message
+func (v ValidationOptions) message(err error) string {
+	switch {
+	case err == ErrIsEmpty && v.IsEmptyLabel != "":
+		return v.IsEmptyLabel
+	case err == ErrNotFound && v.NotFoundLabel != "":
+		return v.NotFoundLabel
+	}
+
+	switch e := err.(type) {
+	case *multiErr:
+		return e.format(v.message)
+	case *multiErrWithPrefix:
+		return v.message(e.MultiError)
+	}
+
+	wrapped := errors.Unwrap(err)
+	if wrapped == nil {
+		return err.Error()
+	}
+
+	// an error that wraps another as the end of its message (e.g. "name: %w") is rebuilt from its own text and the wrapped error's message
+	msg, wrappedMsg := err.Error(), wrapped.Error()
+	if !strings.HasSuffix(msg, wrappedMsg) {
+		return msg
+	}
+
+	return strings.TrimSuffix(msg, wrappedMsg) + v.message(wrapped)
+}
+
+// validationErr retains the original error so that it can still be inspected via errors.Is and errors.As
+type validationErr struct {
+	err error
+	msg string
+}
+
+func (v *validationErr) Error() string {
+	return v.msg
+}
+
+func (v *validationErr) Unwrap() error {
+	return v.err
+}
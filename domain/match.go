@@ -6,12 +6,12 @@ import (
 	"errors"
 	"fmt"
 	"io/fs"
+	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
 	"time"
-
-	"github.com/google/go-cmp/cmp"
 )
 
 type Match struct {
@@ -23,6 +23,124 @@ type Match struct {
 	Winner    *Team
 	Notes     string
 	Completed bool
+	// DecidedOnPenalties indicates that the match was drawn and the winner was decided by a penalty shoot-out
+	DecidedOnPenalties bool
+	// HomePens and AwayPens are the number of penalties scored by each competitor in a penalty shoot-out
+	HomePens uint8
+	AwayPens uint8
+	// ExcludeFromPrizes indicates that the match must not count towards any prize (e.g. a friendly or a void match)
+	ExcludeFromPrizes bool
+	// Attendance is the number of spectators at the match, or 0 if unknown
+	Attendance int
+}
+
+// Competitors returns both of the match's competitors, home first
+func (m *Match) Competitors() [2]MatchCompetitor {
+	return [2]MatchCompetitor{m.Home, m.Away}
+}
+
+// CompetitorForTeam returns the competitor that represents the provided team, and whether this is the home competitor
+//
+// A nil competitor is returned if the provided team is not competing in the match
+func (m *Match) CompetitorForTeam(team *Team) (*MatchCompetitor, bool) {
+	if team == nil {
+		return nil, false
+	}
+
+	switch {
+	case m.Home.Team != nil && m.Home.Team.ID == team.ID:
+		return &m.Home, true
+	case m.Away.Team != nil && m.Away.Team.ID == team.ID:
+		return &m.Away, false
+	default:
+		return nil, false
+	}
+}
+
+// OpponentOf returns the competitor that opposes the provided team, or nil if the provided team is not competing in the match
+func (m *Match) OpponentOf(team *Team) *MatchCompetitor {
+	if team == nil {
+		return nil
+	}
+
+	switch {
+	case m.Home.Team != nil && m.Home.Team.ID == team.ID:
+		return &m.Away
+	case m.Away.Team != nil && m.Away.Team.ID == team.ID:
+		return &m.Home
+	default:
+		return nil
+	}
+}
+
+// Played returns true if the match has kicked off as of the provided time, so that a match in progress can be distinguished from an upcoming match
+//
+// A completed match is always considered to have been played, and a match without a timestamp is never considered to have been played until it is completed
+func (m *Match) Played(now time.Time) bool {
+	if m.Completed {
+		return true
+	}
+
+	if m.Timestamp.IsZero() {
+		return false
+	}
+
+	return !m.Timestamp.After(now)
+}
+
+// PenaltyScore returns the score of the match's penalty shoot-out, home first (e.g. "4-3")
+//
+// Returns an empty string if the match was not decided on penalties
+func (m *Match) PenaltyScore() string {
+	if !m.DecidedOnPenalties {
+		return ""
+	}
+
+	return fmt.Sprintf("%d-%d", m.HomePens, m.AwayPens)
+}
+
+// Outcome returns the winner and loser of the match, or a draw of true if the match was drawn
+//
+// The match's winner takes precedence if it is set (it is derived from the scoreline when matches are loaded), otherwise the winner is
+// determined by goals, followed by the penalty shoot-out of a match that was decided on penalties. The loser is nil if the winner's opponent
+// is not yet known. Both teams are nil and draw is false if the match is not completed (e.g. upcoming, in progress or abandoned), or if its
+// outcome cannot be determined (e.g. either team is not yet known, or a match decided on penalties has neither a winner nor a shoot-out score)
+func (m *Match) Outcome() (winner, loser *Team, draw bool) {
+	if m == nil || !m.Completed {
+		return nil, nil, false
+	}
+
+	if m.Winner != nil {
+		if m.Home.Team != nil && m.Home.Team.ID == m.Winner.ID {
+			return m.Winner, m.Away.Team, false
+		}
+		return m.Winner, m.Home.Team, false
+	}
+
+	if m.Home.Team == nil || m.Away.Team == nil {
+		return nil, nil, false
+	}
+
+	switch {
+	case m.Home.Goals > m.Away.Goals:
+		return m.Home.Team, m.Away.Team, false
+	case m.Away.Goals > m.Home.Goals:
+		return m.Away.Team, m.Home.Team, false
+	case !m.DecidedOnPenalties:
+		return nil, nil, true
+	case m.HomePens > m.AwayPens:
+		return m.Home.Team, m.Away.Team, false
+	case m.AwayPens > m.HomePens:
+		return m.Away.Team, m.Home.Team, false
+	default:
+		return nil, nil, false
+	}
+}
+
+// isWonBy returns true if the match's outcome is a win for the provided team
+func (m *Match) isWonBy(team *Team) bool {
+	winner, _, _ := m.Outcome()
+	return winner != nil && team != nil && winner.ID == team.ID
 }
 
 type MatchStage uint8
@@ -50,7 +168,21 @@ var matchesCSVHeader = []string{
 	"AWAY_OG",
 	"HOME_RED_CARDS",
 	"AWAY_RED_CARDS",
+}
+
+// matchesCSVTimestampColumn defines the column that replaces the DATE and TIME columns of a matches csv that uses combined timestamps
+const matchesCSVTimestampColumn = "TIMESTAMP"
+
+// matchesCSVOptionalHeader defines the columns that a matches csv may omit
+var matchesCSVOptionalHeader = []string{
 	"NOTES",
+	"PENALTIES",
+	"HOME_PENS",
+	"AWAY_PENS",
+	"HOME_SCORERS",
+	"AWAY_SCORERS",
+	"EXCLUDE_FROM_PRIZES",
+	"ATTENDANCE",
 }
 
 type MatchCompetitor struct {
@@ -59,10 +191,12 @@ type MatchCompetitor struct {
 	YellowCards uint8
 	OwnGoals    []MatchEvent
 	RedCards    []MatchEvent
+	Scorers     []MatchEvent
 }
 
 type MatchEvent struct {
 	Name   string // name of player who performed the event
+	Assist string // name of player who assisted the event (e.g. a goal), if any
 	Minute uint8  // match minute event took place
 	Offset uint8  // indicates if event took place in stopped time - e.g. 90+2 = offset 2
 }
@@ -73,6 +207,10 @@ func (m MatchEvent) String() string {
 		minute += fmt.Sprintf("+%d", m.Offset)
 	}
 
+	if m.Assist != "" {
+		return fmt.Sprintf("%s %s (assist: %s)", minute, m.Name, m.Assist)
+	}
+
 	return fmt.Sprintf("%s %s", minute, m.Name)
 }
 
@@ -88,33 +226,234 @@ func (mc MatchCollection) GetByID(id string) *Match {
 	return nil
 }
 
-func (mc MatchCollection) GetWinnerByMatchID(id string) *Team {
-	match := mc.GetByID(id)
+// FilterByStage returns the matches that belong to the provided stage
+func (mc MatchCollection) FilterByStage(stage MatchStage) MatchCollection {
+	var filtered MatchCollection
 
-	if match == nil || !match.Completed {
-		return nil
+	for _, m := range mc {
+		if m.Stage == stage {
+			filtered = append(filtered, m)
+		}
+	}
+
+	return filtered
+}
+
+// FilterByTeam returns the matches in which the provided team is competing
+func (mc MatchCollection) FilterByTeam(team *Team) MatchCollection {
+	var filtered MatchCollection
+
+	for _, m := range mc {
+		if m.OpponentOf(team) != nil {
+			filtered = append(filtered, m)
+		}
 	}
 
-	return match.Winner
+	return filtered
+}
+
+// FilterForPrizes returns the matches that count towards prizes, omitting those that are excluded from prizes
+func (mc MatchCollection) FilterForPrizes() MatchCollection {
+	var filtered MatchCollection
+
+	for _, m := range mc {
+		if !m.ExcludeFromPrizes {
+			filtered = append(filtered, m)
+		}
+	}
+
+	return filtered
+}
+
+func (mc MatchCollection) GetWinnerByMatchID(id string) *Team {
+	winner, _, _ := mc.GetByID(id).Outcome()
+	return winner
 }
 
 func (mc MatchCollection) GetRunnerUpByMatchID(id string) *Team {
-	match := mc.GetByID(id)
+	_, loser, _ := mc.GetByID(id).Outcome()
+	return loser
+}
 
-	if match == nil || !match.Completed || match.Winner == nil {
-		return nil
+// PointsFor returns the points earned by the provided team across the collection's completed matches
+//
+// A win earns 3 points and a draw earns 1 point, so a match decided on penalties is considered to be a draw
+func (mc MatchCollection) PointsFor(team *Team) int {
+	var points int
+
+	for _, m := range mc {
+		if !m.Completed || m.OpponentOf(team) == nil {
+			continue
+		}
+
+		_, _, draw := m.Outcome()
+		switch {
+		case draw || m.DecidedOnPenalties:
+			points++
+		case m.isWonBy(team):
+			points += 3
+		}
+	}
+
+	return points
+}
+
+// HeadToHead returns the record between the provided teams across the collection's completed matches in which they face each other
+//
+// A match decided on penalties is considered to be a win for the match's winner
+func (mc MatchCollection) HeadToHead(a, b *Team) (winsA, winsB, draws int) {
+	if a == nil || b == nil {
+		return 0, 0, 0
+	}
+
+	for _, m := range mc {
+		opponent := m.OpponentOf(a)
+		if !m.Completed || opponent == nil || opponent.Team == nil || opponent.Team.ID != b.ID {
+			continue
+		}
+
+		switch {
+		case m.isWonBy(a):
+			winsA++
+		case m.isWonBy(b):
+			winsB++
+		default:
+			draws++
+		}
+	}
+
+	return winsA, winsB, draws
+}
+
+// FormFor returns the results of the provided team's last n completed matches in chronological order, e.g. "WWDLW"
+//
+// Each result is one of "W" (win), "D" (draw) or "L" (loss), and a match decided on penalties is considered to be a win
+// for the match's winner. Returns an empty string if the team has no completed matches or n is not positive
+func (mc MatchCollection) FormFor(team *Team, n int) string {
+	if team == nil || n <= 0 {
+		return ""
+	}
+
+	var completed MatchCollection
+	for _, m := range mc {
+		if m.Completed && m.OpponentOf(team) != nil {
+			completed = append(completed, m)
+		}
 	}
 
-	if match.Home.Team != nil && match.Home.Team.ID == match.Winner.ID {
-		return match.Away.Team
+	sort.SliceStable(completed, func(i, j int) bool {
+		return completed[i].Timestamp.Before(completed[j].Timestamp)
+	})
+
+	if len(completed) > n {
+		completed = completed[len(completed)-n:]
+	}
+
+	var form strings.Builder
+	for _, m := range completed {
+		winner, _, _ := m.Outcome()
+
+		switch {
+		case winner == nil:
+			form.WriteString("D")
+		case winner.ID == team.ID:
+			form.WriteString("W")
+		default:
+			form.WriteString("L")
+		}
+	}
+
+	return form.String()
+}
+
+// MatchPage represents a single page of a paginated match collection
+type MatchPage struct {
+	Number     int             // number of the page, starting at 1
+	TotalPages int             // total number of pages
+	Matches    MatchCollection // matches on the page, in order of the paginated collection
+}
+
+// IsFirst returns true if the page is the first page
+func (p MatchPage) IsFirst() bool {
+	return p.Number == 1
+}
+
+// IsLast returns true if the page is the last page
+func (p MatchPage) IsLast() bool {
+	return p.Number == p.TotalPages
+}
+
+// Paginate splits the collection into pages of up to perPage matches each, retaining the order of the collection
+//
+// If perPage is zero or less, the collection is not paginated and a single page containing every match is returned (default).
+// An empty collection produces no pages
+func (mc MatchCollection) Paginate(perPage int) []MatchPage {
+	if len(mc) == 0 {
+		return []MatchPage{}
 	}
 
-	return match.Home.Team
+	if perPage <= 0 {
+		perPage = len(mc)
+	}
+
+	total := (len(mc) + perPage - 1) / perPage
+	pages := make([]MatchPage, 0, total)
+
+	for idx := 0; idx < len(mc); idx += perPage {
+		end := idx + perPage
+		if end > len(mc) {
+			end = len(mc)
+		}
+
+		pages = append(pages, MatchPage{
+			Number:     len(pages) + 1,
+			TotalPages: total,
+			Matches:    mc[idx:end],
+		})
+	}
+
+	return pages
+}
+
+// headToHeadRecord represents the record between two teams, for use within a template
+type headToHeadRecord struct {
+	WinsA int
+	WinsB int
+	Draws int
+}
+
+var (
+	// flagTruthyValues defines the (case-insensitive) values that denote a flag column that is set (e.g. a completed match)
+	flagTruthyValues = []string{"Y", "YES", "TRUE", "1"}
+	// flagFalsyValues defines the (case-insensitive) values that explicitly denote a flag column that is not set
+	flagFalsyValues = []string{"", "N", "NO", "FALSE", "0"}
+)
+
+// roundSuffixRx provides a regex pattern matcher that captures the round of a match id, without the numeric suffix which distinguishes
+// matches of the same round - either a suffix after a separator (e.g. "R16_5") or directly after a known round (e.g. "SF1" or "QF3")
+var roundSuffixRx = regexp.MustCompile(`^(.+)[_-]\d+$|^(F|SF|QF)\d+$`)
+
+// inferRound returns the round of the provided match id, by omitting any content inside [] and its numeric suffix
+//
+// For example, matches "SF1" and "SF2" both belong to round "SF", and matches "R16_1 [49]" and "R16_2 [50]" both belong to round "R16".
+// A number that is not separated from an unknown round is part of the round, so match "R16" belongs to round "R16"
+func inferRound(matchID string) string {
+	id := strings.Trim(rx.ReplaceAllString(matchID, ""), " ")
+	return roundSuffixRx.ReplaceAllString(id, "${1}${2}")
 }
 
 type MatchesCSVLoader struct {
-	fSys fs.FS
-	path string
+	fSys              fs.FS
+	path              string
+	glob              string
+	updatesPath       string
+	strictCompleted   bool
+	completedAsOf     Clock
+	combinedTimestamp bool
+	deriveWinners     bool
+	delimiter         rune
+	headerMapping     map[string]string
+	validationOpts    ValidationOptions
 }
 
 func (m *MatchesCSVLoader) WithFileSystem(fSys fs.FS) *MatchesCSVLoader {
@@ -127,25 +466,172 @@ func (m *MatchesCSVLoader) WithPath(path string) *MatchesCSVLoader {
 	return m
 }
 
+// WithGlob loads the matches from each file within the loader's file system that matches the provided pattern (e.g. "matches_*.csv")
+//
+// The files are combined in order of path and validated as a single collection, so a match id must be unique across every file.
+// This takes precedence over WithPath if both are provided
+func (m *MatchesCSVLoader) WithGlob(pattern string) *MatchesCSVLoader {
+	m.glob = pattern
+	return m
+}
+
+// WithUpdatesPath sets the path to an optional file of match updates
+//
+// Rows within the updates file override the rows within the base file that share the same match id,
+// and rows with a match id that does not exist within the base file are appended
+func (m *MatchesCSVLoader) WithUpdatesPath(path string) *MatchesCSVLoader {
+	m.updatesPath = path
+	return m
+}
+
+// WithStrictCompleted determines whether a completed value that is neither truthy nor falsy produces an error
+//
+// If strict is false, any value that is not truthy is considered to denote a match that is not completed
+func (m *MatchesCSVLoader) WithStrictCompleted(strict bool) *MatchesCSVLoader {
+	m.strictCompleted = strict
+	return m
+}
+
+// WithCompletedAsOf considers each match that kicks off before the current time of the provided clock to be completed, if its completed value is omitted
+//
+// This is useful when replaying historical data, which may not denote the matches that have been completed. A completed value that is provided
+// (e.g. "N") is never overridden. If clock is nil, a match is only completed by its completed value (default)
+func (m *MatchesCSVLoader) WithCompletedAsOf(clock Clock) *MatchesCSVLoader {
+	m.completedAsOf = clock
+	return m
+}
+
+// WithCombinedTimestamp determines whether the kick-off of each match is parsed from a single TIMESTAMP column (RFC3339)
+//
+// If combined is false, the kick-off of each match is parsed from separate DATE and TIME columns (default)
+func (m *MatchesCSVLoader) WithCombinedTimestamp(combined bool) *MatchesCSVLoader {
+	m.combinedTimestamp = combined
+	return m
+}
+
+// WithDerivedWinners determines whether the winner of each completed match is derived from its scoreline, so that the WINNER_TEAM_ID column may be omitted
+//
+// If derive is true, the competitor with the most goals wins, and a match with equal goals has no winner unless it was decided on penalties
+// (in which case the competitor with the most pens wins). A winner that is also provided by the WINNER_TEAM_ID column must be consistent with the scoreline
+func (m *MatchesCSVLoader) WithDerivedWinners(derive bool) *MatchesCSVLoader {
+	m.deriveWinners = derive
+	return m
+}
+
+// WithDelimiter sets the rune that separates the fields of each row - e.g. '\t' for a tab-separated file
+//
+// If delimiter is zero, fields are separated by commas (default)
+func (m *MatchesCSVLoader) WithDelimiter(delimiter rune) *MatchesCSVLoader {
+	m.delimiter = delimiter
+	return m
+}
+
+// WithHeaderMapping sets the header that each column is read from, keyed by column name - e.g. {"MATCH_ID": "fixture_id"}
+//
+// If mapping is nil, the header row must consist of the expected column names only (default). Otherwise, each column is read from
+// its mapped header (or from a header that matches its own name if it is not mapped), and any other header is ignored,
+// so that a wider file (such as a stats provider's export) can be loaded as-is
+func (m *MatchesCSVLoader) WithHeaderMapping(mapping map[string]string) *MatchesCSVLoader {
+	m.headerMapping = mapping
+	return m
+}
+
+// WithValidationOptions customises the messages of the errors that are returned when loading matches
+func (m *MatchesCSVLoader) WithValidationOptions(opts ValidationOptions) *MatchesCSVLoader {
+	m.validationOpts = opts
+	return m
+}
+
 func (m *MatchesCSVLoader) init() error {
 	if m.fSys == nil {
 		m.fSys = defaultFileSystem
 	}
 
-	if m.path == "" {
+	if m.path == "" && m.glob == "" {
 		return fmt.Errorf("path: %w", ErrIsEmpty)
 	}
 
 	return nil
 }
 
-func (m *MatchesCSVLoader) LoadMatches(_ context.Context) (MatchCollection, error) {
+func (m *MatchesCSVLoader) LoadMatches(ctx context.Context) (MatchCollection, error) {
+	matches, err := m.loadMatches(ctx)
+	if err != nil {
+		return nil, m.validationOpts.apply(err)
+	}
+
+	return matches, nil
+}
+
+func (m *MatchesCSVLoader) loadMatches(_ context.Context) (MatchCollection, error) {
 	if err := m.init(); err != nil {
 		return nil, err
 	}
 
+	var (
+		matches MatchCollection
+		err     error
+	)
+
+	if m.glob != "" {
+		matches, err = m.loadMatchesFromGlob(m.glob)
+	} else {
+		matches, err = m.loadMatchesFromPath(m.path)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if m.updatesPath == "" {
+		return matches, nil
+	}
+
+	updates, err := m.loadMatchesFromPath(m.updatesPath)
+	if err != nil {
+		return nil, fmt.Errorf("updates: %w", err)
+	}
+
+	return mergeMatches(matches, updates), nil
+}
+
+func (m *MatchesCSVLoader) loadMatchesFromPath(path string) (MatchCollection, error) {
+	matches, err := m.readMatchesFromPath(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return validateMatches(matches)
+}
+
+// loadMatchesFromGlob returns the combined matches of each file that matches the provided pattern, in order of path
+func (m *MatchesCSVLoader) loadMatchesFromGlob(pattern string) (MatchCollection, error) {
+	paths, err := fs.Glob(m.fSys, pattern)
+	if err != nil {
+		return nil, fmt.Errorf("glob '%s': %w", pattern, err)
+	}
+
+	if len(paths) == 0 {
+		return nil, fmt.Errorf("glob '%s': no files match: %w", pattern, ErrNotFound)
+	}
+
+	sort.Strings(paths)
+
+	var matches MatchCollection
+	for _, path := range paths {
+		pathMatches, err := m.readMatchesFromPath(path)
+		if err != nil {
+			return nil, fmt.Errorf("file '%s': %w", path, err)
+		}
+		matches = append(matches, pathMatches...)
+	}
+
+	return validateMatches(matches)
+}
+
+// readMatchesFromPath returns the matches within the file at the provided path, without validating them
+func (m *MatchesCSVLoader) readMatchesFromPath(path string) (MatchCollection, error) {
 	// open matches csv file
-	f, err := m.fSys.Open(m.path)
+	f, err := m.fSys.Open(path)
 	if err != nil {
 		return nil, fmt.Errorf("cannot open file: %w", err)
 	}
@@ -153,27 +639,57 @@ func (m *MatchesCSVLoader) LoadMatches(_ context.Context) (MatchCollection, erro
 	defer f.Close()
 
 	// parse file contents
-	records, err := csv.NewReader(f).ReadAll()
+	reader := csv.NewReader(f)
+	if m.delimiter != 0 {
+		reader.Comma = m.delimiter
+	}
+
+	records, err := reader.ReadAll()
 	if err != nil {
 		return nil, fmt.Errorf("cannot read file: %w", err)
 	}
 
-	// transform and validate
-	matches, err := transformCSVToMatches(records)
+	// transform
+	matches, err := m.transformCSVToMatches(records)
 	if err != nil {
 		return nil, fmt.Errorf("cannot transform csv: %w", err)
 	}
 
-	return validateMatches(matches)
+	return matches, nil
 }
 
-func transformCSVToMatches(records [][]string) (MatchCollection, error) {
+// mergeMatches returns the base matches with each of the provided updates applied, matched by id
+func mergeMatches(base, updates MatchCollection) MatchCollection {
+	merged := make(MatchCollection, len(base))
+	copy(merged, base)
+
+	for _, update := range updates {
+		replaced := false
+
+		for idx, match := range merged {
+			if match.ID == update.ID {
+				merged[idx] = update
+				replaced = true
+				break
+			}
+		}
+
+		if !replaced {
+			merged = append(merged, update)
+		}
+	}
+
+	return merged
+}
+
+func (m *MatchesCSVLoader) transformCSVToMatches(records [][]string) (MatchCollection, error) {
 	if len(records) < 2 {
 		return nil, fmt.Errorf("rows %d: file must have header row and at least one more row", len(records))
 	}
 	headerRow := records[0]
-	if diff := cmp.Diff(headerRow, matchesCSVHeader); diff != "" {
-		return nil, fmt.Errorf("invalid headers: %s", strings.Join(headerRow, ","))
+	columns, err := m.mapColumns(headerRow)
+	if err != nil {
+		return nil, err
 	}
 
 	var (
@@ -184,7 +700,7 @@ func transformCSVToMatches(records [][]string) (MatchCollection, error) {
 	for idx, row := range records[1:] {
 		rowNum := idx + 1
 		mErrRow := mErr.WithPrefix(fmt.Sprintf("row %d", rowNum))
-		match := transformCSVRowToMatch(row, mErrRow)
+		match := m.transformCSVRowToMatch(csvRow{columns: columns, values: row}, mErrRow)
 		matches = append(matches, match)
 	}
 
@@ -195,43 +711,246 @@ func transformCSVToMatches(records [][]string) (MatchCollection, error) {
 	return matches, nil
 }
 
-func transformCSVRowToMatch(row []string, mErr MultiError) *Match {
-	matchID := row[0]             // MATCH_ID
-	sDate := row[1]               // DATE
-	sTime := row[2]               // TIME
-	rawStage := row[3]            // STAGE
-	rawCompleted := row[4]        // COMPLETED
-	winnerTeamID := row[5]        // WINNER_TEAM_ID
-	homeTeamID := row[6]          // HOME_TEAM_ID
-	awayTeamID := row[7]          // AWAY_TEAM_ID
-	rawHomeGoals := row[8]        // HOME_GOALS
-	rawAwayGoals := row[9]        // AWAY_GOALS
-	rawHomeYellowCards := row[10] // HOME_YELLOW_CARDS
-	rawAwayYellowCards := row[11] // AWAY_YELLOW_CARDS
-	rawHomeOG := row[12]          // HOME_OG
-	rawAwayOG := row[13]          // AWAY_OG
-	rawHomeRedCards := row[14]    // HOME_RED_CARDS
-	rawAwayRedCards := row[15]    // AWAY_RED_CARDS
-	notes := row[16]              // NOTES
+// requiredColumns returns the columns that a matches csv must include, based on the loader's options
+func (m *MatchesCSVLoader) requiredColumns() []string {
+	var required []string
+	for _, column := range matchesCSVHeader {
+		switch {
+		case column == "DATE" && m.combinedTimestamp:
+			required = append(required, matchesCSVTimestampColumn)
+		case column == "TIME" && m.combinedTimestamp:
+			// superseded by combined timestamp column
+		case column == "WINNER_TEAM_ID" && m.deriveWinners:
+			// derived from scoreline, so optional
+		default:
+			required = append(required, column)
+		}
+	}
+
+	return required
+}
+
+// optionalColumns returns the columns that a matches csv may omit, based on the loader's options
+func (m *MatchesCSVLoader) optionalColumns() []string {
+	if !m.deriveWinners {
+		return matchesCSVOptionalHeader
+	}
+
+	return append(append([]string{}, matchesCSVOptionalHeader...), "WINNER_TEAM_ID")
+}
+
+// mapColumns returns the index of each column within the provided header row, based on the loader's options
+func (m *MatchesCSVLoader) mapColumns(headerRow []string) (map[string]int, error) {
+	if m.headerMapping != nil {
+		columns, err := mapCSVHeaders(headerRow, m.headerMapping, m.requiredColumns(), m.optionalColumns())
+		if err != nil {
+			return nil, fmt.Errorf("invalid headers: %w", err)
+		}
+		return columns, nil
+	}
+
+	columns, err := mapCSVColumns(headerRow, m.requiredColumns(), m.optionalColumns())
+	if err != nil {
+		return nil, fmt.Errorf("invalid headers: %s: %w", strings.Join(headerRow, ","), err)
+	}
+
+	return columns, nil
+}
+
+// mapCSVHeaders returns the index of each column within the provided header row, keyed by column name
+//
+// Each column is located by its header within the provided mapping (or by its own name if it is not mapped), and any other header is ignored.
+// A header that is read by more than one column, or that appears more than once within the header row, is considered to be a duplicate
+func mapCSVHeaders(headerRow []string, mapping map[string]string, required, optional []string) (map[string]int, error) {
+	mErr := NewMultiError()
+
+	knownColumns := append(append([]string{}, required...), optional...)
+	isKnown := func(column string) bool {
+		for _, known := range knownColumns {
+			if column == known {
+				return true
+			}
+		}
+		return false
+	}
+
+	// sort mapped columns so that errors are reported in a consistent order
+	mappedColumns := make([]string, 0, len(mapping))
+	for column := range mapping {
+		mappedColumns = append(mappedColumns, column)
+	}
+	sort.Strings(mappedColumns)
+
+	for _, column := range mappedColumns {
+		if !isKnown(column) {
+			mErr.Add(fmt.Errorf("mapped column '%s': %w", column, ErrNotFound))
+		}
+	}
+
+	headers := make(map[string][]int)
+	for idx, header := range headerRow {
+		headers[header] = append(headers[header], idx)
+	}
+
+	columns := make(map[string]int)
+	claimed := make(map[string]struct{})
+	for _, column := range knownColumns {
+		header := column
+		if mapped, ok := mapping[column]; ok {
+			header = mapped
+		}
+
+		indexes := headers[header]
+		_, isClaimed := claimed[header]
+		claimed[header] = struct{}{}
+
+		switch {
+		case len(indexes) > 1, len(indexes) == 1 && isClaimed:
+			mErr.Add(fmt.Errorf("column '%s' (header '%s'): %w", column, header, ErrIsDuplicate))
+		case len(indexes) == 1:
+			columns[column] = indexes[0]
+		}
+	}
+
+	for _, column := range required {
+		if _, ok := columns[column]; ok {
+			continue
+		}
+
+		header := column
+		if mapped, ok := mapping[column]; ok {
+			header = mapped
+		}
+
+		if len(headers[header]) == 0 {
+			mErr.Add(fmt.Errorf("column '%s' (header '%s'): %w", column, header, ErrNotFound))
+		}
+	}
+
+	if !mErr.IsEmpty() {
+		return nil, mErr
+	}
+
+	return columns, nil
+}
+
+// mapCSVColumns returns the index of each column within the provided header row, keyed by column name
+//
+// Each header must be a known column that appears only once, and each required column must have a header
+func mapCSVColumns(headerRow, required, optional []string) (map[string]int, error) {
+	mErr := NewMultiError()
+	columns := make(map[string]int)
+
+	knownColumns := append(append([]string{}, required...), optional...)
+	isKnown := func(column string) bool {
+		for _, known := range knownColumns {
+			if column == known {
+				return true
+			}
+		}
+		return false
+	}
+
+	for idx, column := range headerRow {
+		_, isDuplicate := columns[column]
+
+		switch {
+		case !isKnown(column):
+			mErr.Add(fmt.Errorf("header '%s': unrecognised", column))
+		case isDuplicate:
+			mErr.Add(fmt.Errorf("header '%s': %w", column, ErrIsDuplicate))
+		default:
+			columns[column] = idx
+		}
+	}
+
+	for _, column := range required {
+		if _, ok := columns[column]; !ok {
+			mErr.Add(fmt.Errorf("header '%s': %w", column, ErrNotFound))
+		}
+	}
+
+	if !mErr.IsEmpty() {
+		return nil, mErr
+	}
+
+	return columns, nil
+}
+
+// csvRow provides access to the values of a csv row by column name
+type csvRow struct {
+	columns map[string]int
+	values  []string
+}
+
+// get returns the value of the provided column, or an empty string if the column is omitted
+func (c csvRow) get(column string) string {
+	idx, ok := c.columns[column]
+	if !ok || idx >= len(c.values) {
+		return ""
+	}
+
+	return c.values[idx]
+}
+
+func (m *MatchesCSVLoader) transformCSVRowToMatch(row csvRow, mErr MultiError) *Match {
+	matchID := row.get("MATCH_ID")
+	sDate := row.get("DATE")
+	sTime := row.get("TIME")
+	rawStage := row.get("STAGE")
+	rawCompleted := row.get("COMPLETED")
+	winnerTeamID := row.get("WINNER_TEAM_ID")
+	homeTeamID := row.get("HOME_TEAM_ID")
+	awayTeamID := row.get("AWAY_TEAM_ID")
+	rawHomeGoals := row.get("HOME_GOALS")
+	rawAwayGoals := row.get("AWAY_GOALS")
+	rawHomeYellowCards := row.get("HOME_YELLOW_CARDS")
+	rawAwayYellowCards := row.get("AWAY_YELLOW_CARDS")
+	rawHomeOG := row.get("HOME_OG")
+	rawAwayOG := row.get("AWAY_OG")
+	rawHomeRedCards := row.get("HOME_RED_CARDS")
+	rawAwayRedCards := row.get("AWAY_RED_CARDS")
+	notes := row.get("NOTES")
+	rawPenalties := row.get("PENALTIES")
+	rawHomePens := row.get("HOME_PENS")
+	rawAwayPens := row.get("AWAY_PENS")
+	rawHomeScorers := row.get("HOME_SCORERS")
+	rawAwayScorers := row.get("AWAY_SCORERS")
+	rawExcludeFromPrizes := row.get("EXCLUDE_FROM_PRIZES")
+	rawAttendance := row.get("ATTENDANCE")
+
+	var timestamp time.Time
+	if m.combinedTimestamp {
+		timestamp = parseRFC3339Timestamp(row.get(matchesCSVTimestampColumn), mErr)
+	} else {
+		timestamp = parseTimestamp(sDate, sTime, mErr)
+	}
 
 	match := &Match{
 		ID:        matchID,
-		Timestamp: parseTimestamp(sDate, sTime, mErr),
+		Timestamp: timestamp,
 		Stage:     convertToMatchStage(rawStage, mErr),
 		Home: MatchCompetitor{
 			Goals:       parseUInt8(rawHomeGoals, mErr.WithPrefix("home goals")),
 			YellowCards: parseUInt8(rawHomeYellowCards, mErr.WithPrefix("home yellow cards")),
 			OwnGoals:    parseMatchEvents(rawHomeOG, mErr.WithPrefix("home own goals")),
 			RedCards:    parseMatchEvents(rawHomeRedCards, mErr.WithPrefix("home red cards")),
+			Scorers:     parseMatchEvents(rawHomeScorers, mErr.WithPrefix("home scorers")),
 		},
 		Away: MatchCompetitor{
 			Goals:       parseUInt8(rawAwayGoals, mErr.WithPrefix("away goals")),
 			YellowCards: parseUInt8(rawAwayYellowCards, mErr.WithPrefix("away yellow cards")),
 			OwnGoals:    parseMatchEvents(rawAwayOG, mErr.WithPrefix("away own goals")),
 			RedCards:    parseMatchEvents(rawAwayRedCards, mErr.WithPrefix("away red cards")),
+			Scorers:     parseMatchEvents(rawAwayScorers, mErr.WithPrefix("away scorers")),
 		},
-		Notes:     notes,
-		Completed: rawCompleted == "Y",
+		Notes:              notes,
+		Completed:          m.parseCompleted(rawCompleted, timestamp, mErr),
+		DecidedOnPenalties: parseFlag(rawPenalties, "penalties", false, mErr),
+		HomePens:           parseUInt8(rawHomePens, mErr.WithPrefix("home pens")),
+		AwayPens:           parseUInt8(rawAwayPens, mErr.WithPrefix("away pens")),
+		ExcludeFromPrizes:  parseFlag(rawExcludeFromPrizes, "exclude from prizes", false, mErr),
+		Attendance:         parseInt(strings.Trim(rawAttendance, " "), mErr.WithPrefix("attendance")),
 	}
 
 	if homeTeamID != "" {
@@ -250,9 +969,57 @@ func transformCSVRowToMatch(row []string, mErr MultiError) *Match {
 		}
 	}
 
+	if m.deriveWinners {
+		deriveWinner(match, mErr)
+	}
+
 	return match
 }
 
+// parseCompleted returns true if the provided completed value denotes a completed match, or if the value is omitted and the loader
+// considers a match with the provided kick-off to be completed (see WithCompletedAsOf)
+func (m *MatchesCSVLoader) parseCompleted(rawCompleted string, timestamp time.Time, mErr MultiError) bool {
+	if m.completedAsOf != nil && strings.Trim(rawCompleted, " ") == "" {
+		return !timestamp.IsZero() && timestamp.Before(m.completedAsOf.Now())
+	}
+
+	return parseFlag(rawCompleted, "completed", m.strictCompleted, mErr)
+}
+
+// deriveWinner sets the winner of the provided match from its scoreline, if the match is completed and a winner can be determined
+//
+// A winner that is already set must be consistent with the scoreline
+func deriveWinner(match *Match, mErr MultiError) {
+	if !match.Completed || match.Home.Team == nil || match.Away.Team == nil {
+		return
+	}
+
+	var derived *Team
+	switch {
+	case match.Home.Goals > match.Away.Goals:
+		derived = match.Home.Team
+	case match.Away.Goals > match.Home.Goals:
+		derived = match.Away.Team
+	case match.DecidedOnPenalties && match.HomePens > match.AwayPens:
+		derived = match.Home.Team
+	case match.DecidedOnPenalties && match.AwayPens > match.HomePens:
+		derived = match.Away.Team
+	}
+
+	if derived == nil {
+		return // drawn, or decided on penalties without a penalty score
+	}
+
+	if match.Winner == nil {
+		match.Winner = &Team{ID: derived.ID}
+		return
+	}
+
+	if strings.Trim(match.Winner.ID, " ") != strings.Trim(derived.ID, " ") {
+		mErr.Add(fmt.Errorf("winning team id %s conflicts with scoreline winner %s", match.Winner.ID, derived.ID))
+	}
+}
+
 func parseTimestamp(sDate, sTime string, mErr MultiError) time.Time {
 	sTimestamp := strings.Trim(sDate+" "+sTime, " ")
 	if sTimestamp == "" {
@@ -268,6 +1035,50 @@ func parseTimestamp(sDate, sTime string, mErr MultiError) time.Time {
 	return timestamp
 }
 
+func parseRFC3339Timestamp(sTimestamp string, mErr MultiError) time.Time {
+	sTimestamp = strings.Trim(sTimestamp, " ")
+	if sTimestamp == "" {
+		return time.Time{}
+	}
+
+	timestamp, err := time.Parse(time.RFC3339, sTimestamp)
+	if err != nil {
+		mErr.Add(fmt.Errorf("invalid timestamp format: %s", sTimestamp))
+		return time.Time{}
+	}
+
+	return timestamp
+}
+
+// parseFlag returns true if the provided value is truthy
+//
+// If strict is true, a value that is neither truthy nor falsy produces an error
+func parseFlag(sFlag, name string, strict bool, mErr MultiError) bool {
+	sFlag = strings.Trim(sFlag, " ")
+
+	for _, truthy := range flagTruthyValues {
+		if strings.EqualFold(sFlag, truthy) {
+			return true
+		}
+	}
+
+	if strict {
+		var isFalsy bool
+		for _, falsy := range flagFalsyValues {
+			if strings.EqualFold(sFlag, falsy) {
+				isFalsy = true
+				break
+			}
+		}
+
+		if !isFalsy {
+			mErr.Add(fmt.Errorf("invalid %s value: %s", name, sFlag))
+		}
+	}
+
+	return false
+}
+
 func parseUInt8(sInt string, mErr MultiError) uint8 {
 	if sInt == "" {
 		return 0
@@ -282,6 +1093,20 @@ func parseUInt8(sInt string, mErr MultiError) uint8 {
 	return uint8(val)
 }
 
+func parseInt(sInt string, mErr MultiError) int {
+	if sInt == "" {
+		return 0
+	}
+
+	val, err := strconv.Atoi(sInt)
+	if err != nil {
+		mErr.Add(fmt.Errorf("invalid int: %w", err))
+		return 0
+	}
+
+	return val
+}
+
 func parseMatchEvents(sEvents string, mErr MultiError) []MatchEvent {
 	sEvents = strings.Trim(sEvents, " ")
 	if sEvents == "" {
@@ -327,7 +1152,12 @@ func parseMatchEvent(sEvent string, mErr MultiError) *MatchEvent {
 		return nil
 	}
 
-	name := strings.Trim(split[0], " ")
+	name, assist, hasAssist := parseMatchEventNames(split[0])
+	if hasAssist && assist == "" {
+		mErr.Add(fmt.Errorf("assist: %w", ErrIsEmpty))
+		return nil
+	}
+
 	minuteWithOffset := split[1]
 
 	split = strings.SplitN(minuteWithOffset, "+", 2)
@@ -364,11 +1194,26 @@ func parseMatchEvent(sEvent string, mErr MultiError) *MatchEvent {
 
 	return &MatchEvent{
 		Name:   name,
+		Assist: assist,
 		Minute: uint8(minute),
 		Offset: uint8(offset),
 	}
 }
 
+// parseMatchEventNames returns the name of the player who performed the provided event, and the name of the player who assisted it
+//
+// The names are separated by ">" (e.g. "Name>Assist"), and hasAssist is false if the event does not specify an assist at all
+func parseMatchEventNames(sNames string) (name, assist string, hasAssist bool) {
+	split := strings.SplitN(sNames, ">", 2)
+	name = strings.Trim(split[0], " ")
+
+	if len(split) < 2 {
+		return name, "", false
+	}
+
+	return name, strings.Trim(split[1], " "), true
+}
+
 func convertToMatchStage(s string, mErr MultiError) MatchStage {
 	switch s {
 	case "GROUP":
@@ -427,6 +1272,15 @@ func validateMatch(match *Match, mErr MultiError) {
 		mErr.Add(fmt.Errorf("timestamp: %w", ErrIsEmpty))
 	}
 
+	// a knockout match may be a placeholder whose competitors are not yet known, but a group stage match must have both
+	if match.Stage == GroupStage && match.Home.Team == nil {
+		mErr.Add(fmt.Errorf("home team id: %w", ErrIsEmpty))
+	}
+
+	if match.Stage == GroupStage && match.Away.Team == nil {
+		mErr.Add(fmt.Errorf("away team id: %w", ErrIsEmpty))
+	}
+
 	if isTeamIDIdentical(match.Home.Team, match.Away.Team) {
 		mErr.Add(fmt.Errorf("home team id and away team id are identical: %s", match.Home.Team.ID))
 	}
@@ -434,6 +1288,27 @@ func validateMatch(match *Match, mErr MultiError) {
 	if isTeamNotOneOf(match.Winner, match.Home.Team, match.Away.Team) {
 		mErr.Add(fmt.Errorf("winning team id %s must match either home or away team id", match.Winner.ID))
 	}
+
+	if isDrawnWithoutPenalties(match) && match.Winner != nil {
+		mErr.Add(fmt.Errorf("winning team id %s must be empty for a drawn match that was not decided on penalties", match.Winner.ID))
+	}
+
+	if !match.DecidedOnPenalties && (match.HomePens > 0 || match.AwayPens > 0) {
+		mErr.Add(errors.New("home pens and away pens must be empty for a match that was not decided on penalties"))
+	}
+
+	if match.DecidedOnPenalties && match.Home.Goals != match.Away.Goals {
+		mErr.Add(fmt.Errorf("home goals %d and away goals %d must be equal for a match that was decided on penalties", match.Home.Goals, match.Away.Goals))
+	}
+
+	if match.Attendance < 0 {
+		mErr.Add(fmt.Errorf("attendance %d must not be negative", match.Attendance))
+	}
+}
+
+// isDrawnWithoutPenalties returns true if the provided match was completed with equal goals and not decided on penalties
+func isDrawnWithoutPenalties(match *Match) bool {
+	return match.Completed && match.Home.Goals == match.Away.Goals && !match.DecidedOnPenalties
 }
 
 func isTeamIDIdentical(a, b *Team) bool {
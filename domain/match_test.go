@@ -11,6 +11,354 @@ import (
 	"github.com/sweepstake-markup-generator/domain"
 )
 
+func TestMatch_Competitors(t *testing.T) {
+	match := &domain.Match{
+		Home: domain.MatchCompetitor{Team: &domain.Team{ID: "teamA"}, Goals: 2},
+		Away: domain.MatchCompetitor{Team: &domain.Team{ID: "teamB"}, Goals: 1},
+	}
+
+	wantCompetitors := [2]domain.MatchCompetitor{
+		{Team: &domain.Team{ID: "teamA"}, Goals: 2},
+		{Team: &domain.Team{ID: "teamB"}, Goals: 1},
+	}
+
+	cmpDiff(t, wantCompetitors, match.Competitors())
+}
+
+func TestMatch_CompetitorForTeam(t *testing.T) {
+	match := &domain.Match{
+		Home: domain.MatchCompetitor{Team: &domain.Team{ID: "teamA"}, Goals: 2},
+		Away: domain.MatchCompetitor{Team: &domain.Team{ID: "teamB"}, Goals: 1},
+	}
+
+	tt := []struct {
+		name           string
+		team           *domain.Team
+		wantCompetitor *domain.MatchCompetitor
+		wantIsHome     bool
+	}{
+		{
+			name:           "home team must return home competitor",
+			team:           &domain.Team{ID: "teamA"},
+			wantCompetitor: &domain.MatchCompetitor{Team: &domain.Team{ID: "teamA"}, Goals: 2},
+			wantIsHome:     true,
+		},
+		{
+			name:           "away team must return away competitor",
+			team:           &domain.Team{ID: "teamB"},
+			wantCompetitor: &domain.MatchCompetitor{Team: &domain.Team{ID: "teamB"}, Goals: 1},
+		},
+		{
+			name: "non-matching team must return nil",
+			team: &domain.Team{ID: "teamC"},
+			// want nil competitor
+		},
+		{
+			name: "nil team must return nil",
+			// want nil competitor
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			gotCompetitor, gotIsHome := match.CompetitorForTeam(tc.team)
+			cmpDiff(t, tc.wantCompetitor, gotCompetitor)
+			cmpDiff(t, tc.wantIsHome, gotIsHome)
+		})
+	}
+
+	t.Run("returned competitor must belong to match", func(t *testing.T) {
+		gotCompetitor, _ := match.CompetitorForTeam(&domain.Team{ID: "teamB"})
+		if gotCompetitor != &match.Away {
+			t.Fatal("want away competitor of match, got copy")
+		}
+	})
+}
+
+func TestMatch_OpponentOf(t *testing.T) {
+	match := &domain.Match{
+		Home: domain.MatchCompetitor{Team: &domain.Team{ID: "teamA"}, Goals: 2},
+		Away: domain.MatchCompetitor{Team: &domain.Team{ID: "teamB"}, Goals: 1},
+	}
+
+	tt := []struct {
+		name         string
+		match        *domain.Match
+		team         *domain.Team
+		wantOpponent *domain.MatchCompetitor
+	}{
+		{
+			name:         "home team must return away competitor",
+			match:        match,
+			team:         &domain.Team{ID: "teamA"},
+			wantOpponent: &domain.MatchCompetitor{Team: &domain.Team{ID: "teamB"}, Goals: 1},
+		},
+		{
+			name:         "away team must return home competitor",
+			match:        match,
+			team:         &domain.Team{ID: "teamB"},
+			wantOpponent: &domain.MatchCompetitor{Team: &domain.Team{ID: "teamA"}, Goals: 2},
+		},
+		{
+			name:  "non-matching team must return nil",
+			match: match,
+			team:  &domain.Team{ID: "teamC"},
+			// want nil opponent
+		},
+		{
+			name:  "nil team must return nil",
+			match: match,
+			// want nil opponent
+		},
+		{
+			name: "team against a competitor that is not yet known must return competitor without team",
+			match: &domain.Match{
+				Home: domain.MatchCompetitor{Team: &domain.Team{ID: "teamA"}},
+			},
+			team:         &domain.Team{ID: "teamA"},
+			wantOpponent: &domain.MatchCompetitor{},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			gotOpponent := tc.match.OpponentOf(tc.team)
+			cmpDiff(t, tc.wantOpponent, gotOpponent)
+		})
+	}
+}
+
+func TestMatch_Played(t *testing.T) {
+	now := time.Date(2018, 5, 26, 15, 0, 0, 0, time.UTC)
+
+	tt := []struct {
+		name       string
+		match      *domain.Match
+		wantPlayed bool
+	}{
+		{
+			name: "match that kicked off in the past must be played",
+			match: &domain.Match{
+				Timestamp: now.Add(-time.Hour),
+			},
+			wantPlayed: true,
+		},
+		{
+			name: "match that kicks off at the current time must be played",
+			match: &domain.Match{
+				Timestamp: now,
+			},
+			wantPlayed: true,
+		},
+		{
+			name: "match that kicks off in the future must not be played",
+			match: &domain.Match{
+				Timestamp: now.Add(time.Hour),
+			},
+			wantPlayed: false,
+		},
+		{
+			name: "completed match must be played regardless of timestamp",
+			match: &domain.Match{
+				Timestamp: now.Add(time.Hour),
+				Completed: true,
+			},
+			wantPlayed: true,
+		},
+		{
+			name:       "match without timestamp must not be played",
+			match:      &domain.Match{},
+			wantPlayed: false,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			cmpDiff(t, tc.wantPlayed, tc.match.Played(now))
+		})
+	}
+}
+
+func TestMatch_PenaltyScore(t *testing.T) {
+	tt := []struct {
+		name      string
+		match     *domain.Match
+		wantScore string
+	}{
+		{
+			name: "match decided on penalties must return shoot-out score",
+			match: &domain.Match{
+				DecidedOnPenalties: true,
+				HomePens:           4,
+				AwayPens:           3,
+			},
+			wantScore: "4-3",
+		},
+		{
+			name: "match decided on penalties with scoreless competitor must return shoot-out score",
+			match: &domain.Match{
+				DecidedOnPenalties: true,
+				AwayPens:           3,
+			},
+			wantScore: "0-3",
+		},
+		{
+			name:  "match not decided on penalties must return empty string",
+			match: &domain.Match{},
+			// want empty string
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			cmpDiff(t, tc.wantScore, tc.match.PenaltyScore())
+		})
+	}
+}
+
+func TestMatch_Outcome(t *testing.T) {
+	teamA := &domain.Team{ID: "teamA"}
+	teamB := &domain.Team{ID: "teamB"}
+
+	home := func(goals uint8) domain.MatchCompetitor { return domain.MatchCompetitor{Team: teamA, Goals: goals} }
+	away := func(goals uint8) domain.MatchCompetitor { return domain.MatchCompetitor{Team: teamB, Goals: goals} }
+
+	tt := []struct {
+		name       string
+		match      *domain.Match
+		wantWinner *domain.Team
+		wantLoser  *domain.Team
+		wantDraw   bool
+	}{
+		{
+			name:       "home win on goals must return home team as winner",
+			match:      &domain.Match{Completed: true, Home: home(2), Away: away(1)},
+			wantWinner: teamA,
+			wantLoser:  teamB,
+		},
+		{
+			name:       "away win on goals must return away team as winner",
+			match:      &domain.Match{Completed: true, Home: home(0), Away: away(3)},
+			wantWinner: teamB,
+			wantLoser:  teamA,
+		},
+		{
+			name:     "scoring draw must return draw",
+			match:    &domain.Match{Completed: true, Home: home(1), Away: away(1)},
+			wantDraw: true,
+		},
+		{
+			name:     "scoreless draw must return draw",
+			match:    &domain.Match{Completed: true, Home: home(0), Away: away(0)},
+			wantDraw: true,
+		},
+		{
+			name:       "home win on penalties must return home team as winner",
+			match:      &domain.Match{Completed: true, Home: home(1), Away: away(1), DecidedOnPenalties: true, HomePens: 5, AwayPens: 4},
+			wantWinner: teamA,
+			wantLoser:  teamB,
+		},
+		{
+			name:       "away win on penalties must return away team as winner",
+			match:      &domain.Match{Completed: true, Home: home(0), Away: away(0), DecidedOnPenalties: true, HomePens: 2, AwayPens: 4},
+			wantWinner: teamB,
+			wantLoser:  teamA,
+		},
+		{
+			name:  "match decided on penalties without winner or shoot-out score must return nils",
+			match: &domain.Match{Completed: true, Home: home(1), Away: away(1), DecidedOnPenalties: true},
+			// want nils
+		},
+		{
+			name:       "match decided on penalties with winner but without shoot-out score must return winner",
+			match:      &domain.Match{Completed: true, Home: home(1), Away: away(1), DecidedOnPenalties: true, Winner: teamB},
+			wantWinner: teamB,
+			wantLoser:  teamA,
+		},
+		{
+			name:       "home winner must return away team as loser",
+			match:      &domain.Match{Completed: true, Home: home(2), Away: away(0), Winner: teamA},
+			wantWinner: teamA,
+			wantLoser:  teamB,
+		},
+		{
+			name:       "away winner must return home team as loser",
+			match:      &domain.Match{Completed: true, Home: home(0), Away: away(2), Winner: teamB},
+			wantWinner: teamB,
+			wantLoser:  teamA,
+		},
+		{
+			name:       "winner without known opponent must return nil loser",
+			match:      &domain.Match{Completed: true, Home: home(2), Winner: teamA},
+			wantWinner: teamA,
+			// want nil loser
+		},
+		{
+			name:  "win on goals without known away team must return nils",
+			match: &domain.Match{Completed: true, Home: home(2)},
+			// want nils
+		},
+		{
+			name:  "win on goals without known home team must return nils",
+			match: &domain.Match{Completed: true, Away: away(2)},
+			// want nils
+		},
+		{
+			name:  "match that is not completed must return nils",
+			match: &domain.Match{Home: home(2), Away: away(1), Winner: teamA},
+			// want nils
+		},
+		{
+			name:  "drawn match that is not completed must return nils",
+			match: &domain.Match{Home: home(1), Away: away(1)},
+			// want nils
+		},
+		{
+			name: "nil match must return nils",
+			// want nils
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			gotWinner, gotLoser, gotDraw := tc.match.Outcome()
+			cmpDiff(t, tc.wantWinner, gotWinner)
+			cmpDiff(t, tc.wantLoser, gotLoser)
+			cmpDiff(t, tc.wantDraw, gotDraw)
+		})
+	}
+}
+
+func TestMatchEvent_String(t *testing.T) {
+	tt := []struct {
+		name    string
+		event   domain.MatchEvent
+		wantStr string
+	}{
+		{
+			name:    "event without assist must return minute and name",
+			event:   domain.MatchEvent{Name: "Lennon", Minute: 12},
+			wantStr: "12' Lennon",
+		},
+		{
+			name:    "event with offset must return minute with offset and name",
+			event:   domain.MatchEvent{Name: "McCartney", Minute: 45, Offset: 2},
+			wantStr: "45'+2 McCartney",
+		},
+		{
+			name:    "event with assist must return minute, name and assist",
+			event:   domain.MatchEvent{Name: "McCartney", Assist: "Starr", Minute: 45, Offset: 2},
+			wantStr: "45'+2 McCartney (assist: Starr)",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			cmpDiff(t, tc.wantStr, tc.event.String())
+		})
+	}
+}
+
 func TestMatchCollection_GetByID(t *testing.T) {
 	matchA1 := &domain.Match{
 		ID: "matchA",
@@ -60,126 +408,484 @@ func TestMatchCollection_GetByID(t *testing.T) {
 	}
 }
 
-func TestMatchCollection_GetWinnerByMatchID(t *testing.T) {
-	matchID := "test-match"
+func TestMatchCollection_FilterByStage(t *testing.T) {
+	groupA := &domain.Match{ID: "groupA", Stage: domain.GroupStage}
+	groupB := &domain.Match{ID: "groupB", Stage: domain.GroupStage}
+	knockoutA := &domain.Match{ID: "knockoutA", Stage: domain.KnockoutStage}
 
-	team := &domain.Team{
-		ID: "test-team",
-	}
+	collection := domain.MatchCollection{groupA, knockoutA, groupB}
 
 	tt := []struct {
-		name            string
-		matchCollection domain.MatchCollection
-		wantTeam        *domain.Team
+		name        string
+		stage       domain.MatchStage
+		wantMatches domain.MatchCollection
 	}{
 		{
-			name: "existent match id and completed match and non-nil winner must produce the expected team",
-			matchCollection: domain.MatchCollection{
-				{
-					ID:        "test-match",
-					Completed: true,
-					Winner:    team,
-				},
-			},
-			wantTeam: team,
-		},
-		{
-			name: "non-existent match id must return nil",
-			matchCollection: domain.MatchCollection{
-				{
-					ID:        "not-test-match",
-					Completed: true,
-					Winner:    team,
-				},
-			},
-			// wantTeam is nil
+			name:        "group stage must return only group stage matches in original order",
+			stage:       domain.GroupStage,
+			wantMatches: domain.MatchCollection{groupA, groupB},
 		},
 		{
-			name: "match that is not completed must return nil",
-			matchCollection: domain.MatchCollection{
-				{
-					ID:     "test-match",
-					Winner: team,
-					// completed is false
-				},
-			},
-			// wantTeam is nil
+			name:        "knockout stage must return only knockout stage matches",
+			stage:       domain.KnockoutStage,
+			wantMatches: domain.MatchCollection{knockoutA},
 		},
 		{
-			name: "empty team must return nil",
-			matchCollection: domain.MatchCollection{
-				{
-					ID:        "test-match",
-					Completed: true,
-					// winner is nil
-				},
-			},
-			// wantTeam is nil
+			name:  "unknown stage must return no matches",
+			stage: domain.MatchStage(0),
+			// want nil matches
 		},
 	}
 
 	for _, tc := range tt {
 		t.Run(tc.name, func(t *testing.T) {
-			gotTeam := tc.matchCollection.GetWinnerByMatchID(matchID)
-			cmpDiff(t, tc.wantTeam, gotTeam)
+			gotMatches := collection.FilterByStage(tc.stage)
+			cmpDiff(t, tc.wantMatches, gotMatches)
 		})
 	}
 }
 
-func TestMatchCollection_GetRunnerUpByMatchID(t *testing.T) {
-	matchID := "test-match"
+func TestMatchCollection_FilterByTeam(t *testing.T) {
+	home := &domain.Match{ID: "home", Home: domain.MatchCompetitor{Team: teamA}, Away: domain.MatchCompetitor{Team: teamB}}
+	away := &domain.Match{ID: "away", Home: domain.MatchCompetitor{Team: teamC}, Away: domain.MatchCompetitor{Team: teamA}}
+	other := &domain.Match{ID: "other", Home: domain.MatchCompetitor{Team: teamB}, Away: domain.MatchCompetitor{Team: teamC}}
 
-	teamA := &domain.Team{
-		ID: "teamA",
-	}
+	collection := domain.MatchCollection{home, other, away}
 
-	teamB := &domain.Team{
-		ID: "teamB",
+	cmpDiff(t, domain.MatchCollection{home, away}, collection.FilterByTeam(teamA))
+	cmpDiff(t, domain.MatchCollection(nil), collection.FilterByTeam(teamD))
+}
+
+func TestMatchCollection_FilterForPrizes(t *testing.T) {
+	included := &domain.Match{ID: "included", Completed: true}
+	excluded := &domain.Match{ID: "excluded", Completed: true, ExcludeFromPrizes: true}
+	notCompleted := &domain.Match{ID: "notCompleted"}
+
+	collection := domain.MatchCollection{included, excluded, notCompleted}
+
+	gotMatches := collection.FilterForPrizes()
+	cmpDiff(t, domain.MatchCollection{included, notCompleted}, gotMatches)
+}
+
+func TestMatchCollection_PointsFor(t *testing.T) {
+	newMatch := func(home, away *domain.Team, homeGoals, awayGoals uint8) *domain.Match {
+		return &domain.Match{
+			Completed: true,
+			Home:      domain.MatchCompetitor{Team: home, Goals: homeGoals},
+			Away:      domain.MatchCompetitor{Team: away, Goals: awayGoals},
+		}
 	}
 
+	penaltyShootout := newMatch(teamA, teamB, 1, 1)
+	penaltyShootout.DecidedOnPenalties = true
+	penaltyShootout.Winner = teamB
+
+	notCompleted := newMatch(teamA, teamB, 5, 0)
+	notCompleted.Completed = false
+
 	tt := []struct {
-		name            string
-		matchCollection domain.MatchCollection
-		wantTeam        *domain.Team
+		name       string
+		collection domain.MatchCollection
+		team       *domain.Team
+		wantPoints int
 	}{
 		{
-			name: "existent match id and completed match and home team winner must return away team",
-			matchCollection: domain.MatchCollection{
-				{
-					ID:        "test-match",
-					Completed: true,
-					Winner:    teamA,
-					Home: domain.MatchCompetitor{
-						Team: teamA,
-					},
-					Away: domain.MatchCompetitor{
-						Team: teamB,
-					},
-				},
-			},
-			wantTeam: teamB,
+			name:       "home win must earn 3 points",
+			collection: domain.MatchCollection{newMatch(teamA, teamB, 2, 1)},
+			team:       teamA,
+			wantPoints: 3,
 		},
 		{
-			name: "existent match id and completed match and away team winner must return home team",
-			matchCollection: domain.MatchCollection{
-				{
-					ID:        "test-match",
-					Completed: true,
-					Winner:    teamB,
-					Home: domain.MatchCompetitor{
-						Team: teamA,
-					},
-					Away: domain.MatchCompetitor{
-						Team: teamB,
-					},
-				},
-			},
-			wantTeam: teamA,
+			name:       "away win must earn 3 points",
+			collection: domain.MatchCollection{newMatch(teamB, teamA, 0, 1)},
+			team:       teamA,
+			wantPoints: 3,
 		},
 		{
-			name: "existent match id and completed match and nil non-winning team must return nil",
-			matchCollection: domain.MatchCollection{
-				{
+			name:       "draw must earn 1 point",
+			collection: domain.MatchCollection{newMatch(teamA, teamB, 2, 2)},
+			team:       teamA,
+			wantPoints: 1,
+		},
+		{
+			name:       "loss must earn 0 points",
+			collection: domain.MatchCollection{newMatch(teamA, teamB, 0, 3)},
+			team:       teamA,
+			wantPoints: 0,
+		},
+		{
+			name:       "penalty shootout winner must earn 1 point",
+			collection: domain.MatchCollection{penaltyShootout},
+			team:       teamB,
+			wantPoints: 1,
+		},
+		{
+			name:       "penalty shootout loser must earn 1 point",
+			collection: domain.MatchCollection{penaltyShootout},
+			team:       teamA,
+			wantPoints: 1,
+		},
+		{
+			name: "points must be combined across completed matches that include the team",
+			collection: domain.MatchCollection{
+				newMatch(teamA, teamB, 2, 1), // win
+				newMatch(teamC, teamA, 1, 1), // draw
+				newMatch(teamA, teamD, 0, 1), // loss
+				newMatch(teamB, teamC, 4, 0), // not competing
+				notCompleted,
+			},
+			team:       teamA,
+			wantPoints: 4,
+		},
+		{
+			name:       "nil team must earn 0 points",
+			collection: domain.MatchCollection{newMatch(teamA, teamB, 2, 1)},
+			wantPoints: 0,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			cmpDiff(t, tc.wantPoints, tc.collection.PointsFor(tc.team))
+		})
+	}
+}
+
+func TestMatchCollection_HeadToHead(t *testing.T) {
+	newMatch := func(home, away *domain.Team, homeGoals, awayGoals uint8) *domain.Match {
+		return &domain.Match{
+			Completed: true,
+			Home:      domain.MatchCompetitor{Team: home, Goals: homeGoals},
+			Away:      domain.MatchCompetitor{Team: away, Goals: awayGoals},
+		}
+	}
+
+	penaltyShootout := newMatch(teamB, teamA, 2, 2)
+	penaltyShootout.DecidedOnPenalties = true
+	penaltyShootout.Winner = teamA
+
+	notCompleted := newMatch(teamA, teamB, 5, 0)
+	notCompleted.Completed = false
+
+	mixedResults := domain.MatchCollection{
+		newMatch(teamA, teamB, 2, 1), // win for a
+		newMatch(teamB, teamA, 3, 0), // win for b
+		newMatch(teamA, teamB, 1, 1), // draw
+		penaltyShootout,              // win for a
+		newMatch(teamA, teamC, 4, 0), // not competing against b
+		newMatch(teamC, teamB, 0, 4), // not competing against a
+		notCompleted,
+	}
+
+	tt := []struct {
+		name       string
+		collection domain.MatchCollection
+		a, b       *domain.Team
+		wantWinsA  int
+		wantWinsB  int
+		wantDraws  int
+	}{
+		{
+			name:       "mix of results must produce the expected record",
+			collection: mixedResults,
+			a:          teamA,
+			b:          teamB,
+			wantWinsA:  2,
+			wantWinsB:  1,
+			wantDraws:  1,
+		},
+		{
+			name:       "teams in reverse order must produce the reverse record",
+			collection: mixedResults,
+			a:          teamB,
+			b:          teamA,
+			wantWinsA:  1,
+			wantWinsB:  2,
+			wantDraws:  1,
+		},
+		{
+			name:       "teams that have not faced each other must produce an empty record",
+			collection: mixedResults,
+			a:          teamA,
+			b:          teamD,
+		},
+		{
+			name:       "nil team must produce an empty record",
+			collection: mixedResults,
+			a:          teamA,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			gotWinsA, gotWinsB, gotDraws := tc.collection.HeadToHead(tc.a, tc.b)
+			cmpDiff(t, tc.wantWinsA, gotWinsA)
+			cmpDiff(t, tc.wantWinsB, gotWinsB)
+			cmpDiff(t, tc.wantDraws, gotDraws)
+		})
+	}
+}
+
+func TestMatchCollection_FormFor(t *testing.T) {
+	newMatch := func(home, away *domain.Team, homeGoals, awayGoals uint8, ts time.Time) *domain.Match {
+		return &domain.Match{
+			Timestamp: ts,
+			Completed: true,
+			Home:      domain.MatchCompetitor{Team: home, Goals: homeGoals},
+			Away:      domain.MatchCompetitor{Team: away, Goals: awayGoals},
+		}
+	}
+
+	penaltyShootout := newMatch(teamB, teamA, 1, 1, date3)
+	penaltyShootout.DecidedOnPenalties = true
+	penaltyShootout.Winner = teamB
+
+	notCompleted := newMatch(teamA, teamD, 5, 0, date3.Add(time.Hour))
+	notCompleted.Completed = false
+
+	// not in chronological order
+	mixedResults := domain.MatchCollection{
+		newMatch(teamC, teamA, 0, 2, date2), // win for a
+		penaltyShootout,                     // loss for a
+		newMatch(teamA, teamB, 1, 1, date1), // draw
+		newMatch(teamB, teamC, 3, 0, date2), // not competing
+		notCompleted,
+	}
+
+	tt := []struct {
+		name     string
+		team     *domain.Team
+		n        int
+		wantForm string
+	}{
+		{
+			name:     "mix of results must produce the expected form in chronological order",
+			team:     teamA,
+			n:        5,
+			wantForm: "DWL",
+		},
+		{
+			name:     "fewer matches than n must produce every result",
+			team:     teamC,
+			n:        3,
+			wantForm: "LL",
+		},
+		{
+			name:     "more matches than n must produce only the last n results",
+			team:     teamA,
+			n:        2,
+			wantForm: "WL",
+		},
+		{
+			name:     "penalty shootout must be a win for the match winner",
+			team:     teamB,
+			n:        1,
+			wantForm: "W",
+		},
+		{
+			name: "team with no completed matches must produce an empty form",
+			team: teamD,
+			n:    5,
+		},
+		{
+			name: "non-positive n must produce an empty form",
+			team: teamA,
+		},
+		{
+			name: "nil team must produce an empty form",
+			n:    5,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			cmpDiff(t, tc.wantForm, mixedResults.FormFor(tc.team, tc.n))
+		})
+	}
+}
+
+func TestMatchCollection_Paginate(t *testing.T) {
+	m1, m2, m3, m4, m5 := &domain.Match{ID: "1"}, &domain.Match{ID: "2"}, &domain.Match{ID: "3"}, &domain.Match{ID: "4"}, &domain.Match{ID: "5"}
+
+	tt := []struct {
+		name       string
+		collection domain.MatchCollection
+		perPage    int
+		wantPages  []domain.MatchPage
+	}{
+		{
+			name:       "matches must be split across pages with a partial last page",
+			collection: domain.MatchCollection{m1, m2, m3, m4, m5},
+			perPage:    3,
+			wantPages: []domain.MatchPage{
+				{Number: 1, TotalPages: 2, Matches: domain.MatchCollection{m1, m2, m3}},
+				{Number: 2, TotalPages: 2, Matches: domain.MatchCollection{m4, m5}},
+			},
+		},
+		{
+			name:       "matches that fill each page exactly must not produce an empty last page",
+			collection: domain.MatchCollection{m1, m2, m3, m4},
+			perPage:    2,
+			wantPages: []domain.MatchPage{
+				{Number: 1, TotalPages: 2, Matches: domain.MatchCollection{m1, m2}},
+				{Number: 2, TotalPages: 2, Matches: domain.MatchCollection{m3, m4}},
+			},
+		},
+		{
+			name:       "zero matches per page must produce a single page",
+			collection: domain.MatchCollection{m1, m2, m3},
+			wantPages: []domain.MatchPage{
+				{Number: 1, TotalPages: 1, Matches: domain.MatchCollection{m1, m2, m3}},
+			},
+		},
+		{
+			name:       "more matches per page than matches must produce a single page",
+			collection: domain.MatchCollection{m1, m2},
+			perPage:    10,
+			wantPages: []domain.MatchPage{
+				{Number: 1, TotalPages: 1, Matches: domain.MatchCollection{m1, m2}},
+			},
+		},
+		{
+			name:      "empty collection must produce no pages",
+			perPage:   3,
+			wantPages: []domain.MatchPage{},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			gotPages := tc.collection.Paginate(tc.perPage)
+			cmpDiff(t, tc.wantPages, gotPages)
+
+			for idx, page := range gotPages {
+				cmpDiff(t, idx == 0, page.IsFirst())
+				cmpDiff(t, idx == len(gotPages)-1, page.IsLast())
+			}
+		})
+	}
+}
+
+func TestMatchCollection_GetWinnerByMatchID(t *testing.T) {
+	matchID := "test-match"
+
+	team := &domain.Team{
+		ID: "test-team",
+	}
+
+	tt := []struct {
+		name            string
+		matchCollection domain.MatchCollection
+		wantTeam        *domain.Team
+	}{
+		{
+			name: "existent match id and completed match and non-nil winner must produce the expected team",
+			matchCollection: domain.MatchCollection{
+				{
+					ID:        "test-match",
+					Completed: true,
+					Winner:    team,
+				},
+			},
+			wantTeam: team,
+		},
+		{
+			name: "non-existent match id must return nil",
+			matchCollection: domain.MatchCollection{
+				{
+					ID:        "not-test-match",
+					Completed: true,
+					Winner:    team,
+				},
+			},
+			// wantTeam is nil
+		},
+		{
+			name: "match that is not completed must return nil",
+			matchCollection: domain.MatchCollection{
+				{
+					ID:     "test-match",
+					Winner: team,
+					// completed is false
+				},
+			},
+			// wantTeam is nil
+		},
+		{
+			name: "empty team must return nil",
+			matchCollection: domain.MatchCollection{
+				{
+					ID:        "test-match",
+					Completed: true,
+					// winner is nil
+				},
+			},
+			// wantTeam is nil
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			gotTeam := tc.matchCollection.GetWinnerByMatchID(matchID)
+			cmpDiff(t, tc.wantTeam, gotTeam)
+		})
+	}
+}
+
+func TestMatchCollection_GetRunnerUpByMatchID(t *testing.T) {
+	matchID := "test-match"
+
+	teamA := &domain.Team{
+		ID: "teamA",
+	}
+
+	teamB := &domain.Team{
+		ID: "teamB",
+	}
+
+	tt := []struct {
+		name            string
+		matchCollection domain.MatchCollection
+		wantTeam        *domain.Team
+	}{
+		{
+			name: "existent match id and completed match and home team winner must return away team",
+			matchCollection: domain.MatchCollection{
+				{
+					ID:        "test-match",
+					Completed: true,
+					Winner:    teamA,
+					Home: domain.MatchCompetitor{
+						Team: teamA,
+					},
+					Away: domain.MatchCompetitor{
+						Team: teamB,
+					},
+				},
+			},
+			wantTeam: teamB,
+		},
+		{
+			name: "existent match id and completed match and away team winner must return home team",
+			matchCollection: domain.MatchCollection{
+				{
+					ID:        "test-match",
+					Completed: true,
+					Winner:    teamB,
+					Home: domain.MatchCompetitor{
+						Team: teamA,
+					},
+					Away: domain.MatchCompetitor{
+						Team: teamB,
+					},
+				},
+			},
+			wantTeam: teamA,
+		},
+		{
+			name: "existent match id and completed match and nil non-winning team must return nil",
+			matchCollection: domain.MatchCollection{
+				{
 					ID:        "test-match",
 					Completed: true,
 					Winner:    teamA,
@@ -564,34 +1270,228 @@ func TestMatchesCSVLoader_LoadMatches(t *testing.T) {
 		{
 			name:     "file with invalid header row must produce the expected error",
 			testFile: "matches_invalid_header_row.csv",
-			wantErr:  errors.New("cannot transform csv: invalid headers: header,row"),
-		},
-		{
-			name:     "file with invalid timestamps must produce the expected error",
-			testFile: "matches_rows_with_invalid_timestamp.csv",
-			wantErr: fmt.Errorf("cannot transform csv: %w", newMultiError([]string{
-				"row 1: invalid timestamp format: epic fail",
-				"row 2: invalid timestamp format: sad 15:00",
-				"row 3: invalid timestamp format: 02/06/2018 times",
-			})),
-		},
-		{
-			name:     "file with invalid stage must produce the expected error",
-			testFile: "matches_rows_with_invalid_stage.csv",
-			wantErr: fmt.Errorf("cannot transform csv: %w", newMultiError([]string{
-				"row 1: invalid match stage: NOT_A_VALID_STAGE",
+			wantErr: fmt.Errorf("cannot transform csv: invalid headers: header,row: %w", newMultiError([]string{
+				"header 'header': unrecognised",
+				"header 'row': unrecognised",
+				"header 'MATCH_ID': not found",
+				"header 'DATE': not found",
+				"header 'TIME': not found",
+				"header 'STAGE': not found",
+				"header 'COMPLETED': not found",
+				"header 'WINNER_TEAM_ID': not found",
+				"header 'HOME_TEAM_ID': not found",
+				"header 'AWAY_TEAM_ID': not found",
+				"header 'HOME_GOALS': not found",
+				"header 'AWAY_GOALS': not found",
+				"header 'HOME_YELLOW_CARDS': not found",
+				"header 'AWAY_YELLOW_CARDS': not found",
+				"header 'HOME_OG': not found",
+				"header 'AWAY_OG': not found",
+				"header 'HOME_RED_CARDS': not found",
+				"header 'AWAY_RED_CARDS': not found",
 			})),
 		},
 		{
-			name:     "file with invalid goals must produce the expected error",
-			testFile: "matches_rows_with_invalid_goals.csv",
-			wantErr: fmt.Errorf("cannot transform csv: %w", newMultiError([]string{
-				`row 1: home goals: invalid int: strconv.Atoi: parsing "OH": invalid syntax`,
-				`row 1: away goals: invalid int: strconv.Atoi: parsing "NO!": invalid syntax`,
-			})),
+			name:     "file without optional notes column must be loaded successfully",
+			testFile: "matches_without_notes_column.csv",
+			wantMatches: domain.MatchCollection{
+				{
+					ID:        "A1",
+					Timestamp: time.Date(2018, 5, 26, 14, 0, 0, 0, time.UTC),
+					Stage:     domain.GroupStage,
+					Home: domain.MatchCompetitor{
+						Team:  &domain.Team{ID: "STHFC"},
+						Goals: 2,
+					},
+					Away: domain.MatchCompetitor{
+						Team:        &domain.Team{ID: "PTFC"},
+						YellowCards: 2,
+					},
+					Winner:    &domain.Team{ID: "STHFC"},
+					Completed: true,
+					// notes are empty
+				},
+			},
 		},
 		{
-			name:     "file with invalid yellow cards must produce the expected error",
+			name:     "drawn match decided on penalties must be loaded successfully",
+			testFile: "matches_rows_with_penalties.csv",
+			wantMatches: domain.MatchCollection{
+				{
+					ID:        "F",
+					Timestamp: time.Date(2018, 5, 26, 14, 0, 0, 0, time.UTC),
+					Stage:     domain.KnockoutStage,
+					Home: domain.MatchCompetitor{
+						Team:  &domain.Team{ID: "STHFC"},
+						Goals: 1,
+					},
+					Away: domain.MatchCompetitor{
+						Team:  &domain.Team{ID: "PTFC"},
+						Goals: 1,
+					},
+					Winner:             &domain.Team{ID: "PTFC"},
+					Notes:              "Poole Town win 4-3 on penalties",
+					Completed:          true,
+					DecidedOnPenalties: true,
+					HomePens:           3,
+					AwayPens:           4,
+				},
+			},
+		},
+		{
+			name:     "match with scorers must be loaded successfully",
+			testFile: "matches_rows_with_scorers.csv",
+			wantMatches: domain.MatchCollection{
+				{
+					ID:        "F",
+					Timestamp: time.Date(2018, 5, 26, 14, 0, 0, 0, time.UTC),
+					Stage:     domain.KnockoutStage,
+					Home: domain.MatchCompetitor{
+						Team:  &domain.Team{ID: "STHFC"},
+						Goals: 1,
+						Scorers: []domain.MatchEvent{
+							{Name: "Lennon", Minute: 12},
+						},
+					},
+					Away: domain.MatchCompetitor{
+						Team:  &domain.Team{ID: "PTFC"},
+						Goals: 2,
+						Scorers: []domain.MatchEvent{
+							{Name: "McCartney", Assist: "Starr", Minute: 45, Offset: 2},
+							{Name: "Harrison", Minute: 78}, // no assist
+						},
+					},
+					Winner:    &domain.Team{ID: "PTFC"},
+					Completed: true,
+				},
+			},
+		},
+		{
+			name:     "match attendance must be loaded successfully",
+			testFile: "matches_rows_with_attendance.csv",
+			wantMatches: domain.MatchCollection{
+				{
+					ID:        "SF1",
+					Timestamp: time.Date(2018, 5, 26, 14, 0, 0, 0, time.UTC),
+					Stage:     domain.KnockoutStage,
+					Home: domain.MatchCompetitor{
+						Team:  &domain.Team{ID: "PTFC"},
+						Goals: 2,
+					},
+					Away: domain.MatchCompetitor{
+						Team:  &domain.Team{ID: "BPFC"},
+						Goals: 1,
+					},
+					Winner:     &domain.Team{ID: "PTFC"},
+					Completed:  true,
+					Attendance: 12345,
+				},
+				{
+					ID:        "SF2",
+					Timestamp: time.Date(2018, 5, 26, 17, 0, 0, 0, time.UTC),
+					Stage:     domain.KnockoutStage,
+					Home: domain.MatchCompetitor{
+						Team: &domain.Team{ID: "WTFC"},
+					},
+					Away: domain.MatchCompetitor{
+						Team: &domain.Team{ID: "DTFC"},
+					},
+					// empty attendance is unknown
+				},
+			},
+		},
+		{
+			name:     "match excluded from prizes must be loaded successfully",
+			testFile: "matches_rows_with_excluded_from_prizes.csv",
+			wantMatches: domain.MatchCollection{
+				{
+					ID:        "FR1",
+					Timestamp: time.Date(2018, 5, 26, 14, 0, 0, 0, time.UTC),
+					Stage:     domain.GroupStage,
+					Home: domain.MatchCompetitor{
+						Team:  &domain.Team{ID: "STHFC"},
+						Goals: 2,
+					},
+					Away: domain.MatchCompetitor{
+						Team:        &domain.Team{ID: "PTFC"},
+						YellowCards: 2,
+					},
+					Winner:            &domain.Team{ID: "STHFC"},
+					Notes:             "Friendly",
+					Completed:         true,
+					ExcludeFromPrizes: true,
+				},
+			},
+		},
+		{
+			name:     "file with columns in a different order must be loaded successfully",
+			testFile: "matches_with_notes_column_first.csv",
+			wantMatches: domain.MatchCollection{
+				{
+					ID:        "A1",
+					Timestamp: time.Date(2018, 5, 26, 14, 0, 0, 0, time.UTC),
+					Stage:     domain.GroupStage,
+					Home: domain.MatchCompetitor{
+						Team:  &domain.Team{ID: "STHFC"},
+						Goals: 2,
+					},
+					Away: domain.MatchCompetitor{
+						Team:        &domain.Team{ID: "PTFC"},
+						YellowCards: 2,
+					},
+					Winner:    &domain.Team{ID: "STHFC"},
+					Notes:     "decided by golden goal",
+					Completed: true,
+				},
+			},
+		},
+		{
+			name:     "file with missing required header must produce the expected error",
+			testFile: "matches_invalid_header_missing_column.csv",
+			wantErr: fmt.Errorf("cannot transform csv: invalid headers: MATCH_ID,DATE,TIME,STAGE,COMPLETED,WINNER_TEAM_ID,HOME_TEAM_ID,AWAY_TEAM_ID,HOME_GOALS,HOME_YELLOW_CARDS,AWAY_YELLOW_CARDS,HOME_OG,AWAY_OG,HOME_RED_CARDS,AWAY_RED_CARDS,NOTES: %w", newMultiError([]string{
+				"header 'AWAY_GOALS': not found",
+			})),
+		},
+		{
+			name:     "file with invalid timestamps must produce the expected error",
+			testFile: "matches_rows_with_invalid_timestamp.csv",
+			wantErr: fmt.Errorf("cannot transform csv: %w", newMultiError([]string{
+				"row 1: invalid timestamp format: epic fail",
+				"row 2: invalid timestamp format: sad 15:00",
+				"row 3: invalid timestamp format: 02/06/2018 times",
+			})),
+		},
+		{
+			name:     "file with invalid stage must produce the expected error",
+			testFile: "matches_rows_with_invalid_stage.csv",
+			wantErr: fmt.Errorf("cannot transform csv: %w", newMultiError([]string{
+				"row 1: invalid match stage: NOT_A_VALID_STAGE",
+			})),
+		},
+		{
+			name:     "file with invalid goals must produce the expected error",
+			testFile: "matches_rows_with_invalid_goals.csv",
+			wantErr: fmt.Errorf("cannot transform csv: %w", newMultiError([]string{
+				`row 1: home goals: invalid int: strconv.Atoi: parsing "OH": invalid syntax`,
+				`row 1: away goals: invalid int: strconv.Atoi: parsing "NO!": invalid syntax`,
+			})),
+		},
+		{
+			name:     "file with invalid attendance must produce the expected error",
+			testFile: "matches_rows_with_invalid_attendance.csv",
+			wantErr: fmt.Errorf("cannot transform csv: %w", newMultiError([]string{
+				`row 1: attendance: invalid int: strconv.Atoi: parsing "LOTS": invalid syntax`,
+			})),
+		},
+		{
+			name:     "file with negative attendance must produce the expected error",
+			testFile: "matches_rows_with_negative_attendance.csv",
+			wantErr: newMultiError([]string{
+				`index 0: attendance -100 must not be negative`,
+			}),
+		},
+		{
+			name:     "file with invalid yellow cards must produce the expected error",
 			testFile: "matches_rows_with_invalid_yellow_cards.csv",
 			wantErr: fmt.Errorf("cannot transform csv: %w", newMultiError([]string{
 				`row 1: home yellow cards: invalid int: strconv.Atoi: parsing "OH": invalid syntax`,
@@ -617,6 +1517,7 @@ func TestMatchesCSVLoader_LoadMatches(t *testing.T) {
 				`row 4: away own goals: event 1: minute: must be greater than 0`,
 				`row 5: home red cards: event 1: offset: invalid int: strconv.Atoi: parsing "invalidNumber": invalid syntax`,
 				`row 6: away red cards: event 1: offset: must be greater than 0`,
+				`row 7: home own goals: event 1: assist: is empty`,
 			})),
 		},
 
@@ -641,6 +1542,17 @@ func TestMatchesCSVLoader_LoadMatches(t *testing.T) {
 				`index 0: home team id and away team id are identical: PTFC`,
 			}),
 		},
+		{
+			name:     "missing team ids for group stage matches must produce the expected error",
+			testFile: "matches_rows_with_missing_team_ids.csv",
+			wantErr: newMultiError([]string{
+				`index 0: home team id: is empty`,
+				`index 1: away team id: is empty`,
+				`index 2: home team id: is empty`,
+				`index 2: away team id: is empty`,
+				// missing team ids for knockout matches are placeholders, so produce no error
+			}),
+		},
 		{
 			name:     "winning team id is not home or away team id must produce the expected error",
 			testFile: "matches_rows_with_mismatch_winning_team_id.csv",
@@ -648,6 +1560,29 @@ func TestMatchesCSVLoader_LoadMatches(t *testing.T) {
 				`index 0: winning team id ABC must match either home or away team id`,
 			}),
 		},
+		{
+			name:     "winning team id for drawn match not decided on penalties must produce the expected error",
+			testFile: "matches_rows_with_drawn_winner.csv",
+			wantErr: newMultiError([]string{
+				`index 0: winning team id PTFC must be empty for a drawn match that was not decided on penalties`,
+				`index 3: winning team id WTFC must be empty for a drawn match that was not decided on penalties`,
+			}),
+		},
+		{
+			name:     "pens for match not decided on penalties must produce the expected error",
+			testFile: "matches_rows_with_pens_without_penalties.csv",
+			wantErr: newMultiError([]string{
+				`index 0: home pens and away pens must be empty for a match that was not decided on penalties`,
+				`index 2: home pens and away pens must be empty for a match that was not decided on penalties`,
+			}),
+		},
+		{
+			name:     "match decided on penalties without equal goals must produce the expected error",
+			testFile: "matches_rows_with_penalties_without_draw.csv",
+			wantErr: newMultiError([]string{
+				`index 0: home goals 2 and away goals 1 must be equal for a match that was decided on penalties`,
+			}),
+		},
 		{
 			name:     "duplicate match id must produce the expected error",
 			testFile: "matches_rows_with_duplicate_id.csv",
@@ -668,6 +1603,613 @@ func TestMatchesCSVLoader_LoadMatches(t *testing.T) {
 	}
 }
 
+func TestMatchesCSVLoader_LoadMatches_WithUpdates(t *testing.T) {
+	baseMatches, err := newMatchesCSVLoader("matches_ok.csv").LoadMatches(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tt := []struct {
+		name        string
+		updatesFile string
+		wantMatches domain.MatchCollection
+		wantErr     error
+	}{
+		{
+			name:        "updates that share a match id with the base file must override the base match",
+			updatesFile: "matches_updates_ok.csv",
+			wantMatches: func() domain.MatchCollection {
+				matches := append(domain.MatchCollection{}, baseMatches[:len(baseMatches)-2]...)
+				return append(matches,
+					&domain.Match{
+						ID:        "SF2",
+						Timestamp: time.Date(2018, 6, 1, 15, 0, 0, 0, time.UTC),
+						Stage:     domain.KnockoutStage,
+						Home: domain.MatchCompetitor{
+							Team:        &domain.Team{ID: "DYFC"},
+							Goals:       2,
+							YellowCards: 2,
+						},
+						Away: domain.MatchCompetitor{
+							Team:     &domain.Team{ID: "BPFC"},
+							Goals:    1,
+							OwnGoals: []domain.MatchEvent{{Name: "Lomeli", Minute: 67}, {Name: "Prichard", Minute: 89}},
+						},
+						Winner:    &domain.Team{ID: "DYFC"},
+						Completed: true,
+					},
+					&domain.Match{
+						ID:        "F",
+						Timestamp: time.Date(2018, 6, 2, 15, 0, 0, 0, time.UTC),
+						Stage:     domain.KnockoutStage,
+						Home: domain.MatchCompetitor{
+							Team:        &domain.Team{ID: "PTFC"},
+							Goals:       3,
+							YellowCards: 1,
+						},
+						Away: domain.MatchCompetitor{
+							Team:        &domain.Team{ID: "DYFC"},
+							Goals:       1,
+							YellowCards: 2,
+						},
+						Winner:    &domain.Team{ID: "PTFC"},
+						Completed: true,
+					},
+				)
+			}(),
+		},
+		{
+			name:        "updates with a match id that does not exist in the base file must be appended",
+			updatesFile: "matches_updates_new_match.csv",
+			wantMatches: append(append(domain.MatchCollection{}, baseMatches...), &domain.Match{
+				ID:        "3P",
+				Timestamp: time.Date(2018, 6, 2, 12, 0, 0, 0, time.UTC),
+				Stage:     domain.KnockoutStage,
+				Home: domain.MatchCompetitor{
+					Team: &domain.Team{ID: "DTFC"},
+				},
+			}),
+		},
+		{
+			name:        "updates with duplicate match id must produce the expected error",
+			updatesFile: "matches_updates_with_duplicate_id.csv",
+			wantErr: fmt.Errorf("updates: %w", newMultiError([]string{
+				`index 1: id 'F': is duplicate`,
+			})),
+		},
+		{
+			name:        "non-existent updates path must produce the expected error",
+			updatesFile: "non-existent.csv",
+			wantErr:     fs.ErrNotExist,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			loader := newMatchesCSVLoader("matches_ok.csv").
+				WithUpdatesPath(filepath.Join(testdataDir, matchesDir, tc.updatesFile))
+			gotMatches, gotErr := loader.LoadMatches(nil)
+
+			cmpError(t, tc.wantErr, gotErr)
+			cmpDiff(t, tc.wantMatches, gotMatches)
+		})
+	}
+}
+
+func TestMatchesCSVLoader_LoadMatches_WithGlob(t *testing.T) {
+	tt := []struct {
+		name        string
+		pattern     string
+		wantMatches domain.MatchCollection
+		wantErr     error
+	}{
+		{
+			name:    "glob matching two files must combine their matches in order of path",
+			pattern: "matches_*.csv",
+			wantMatches: domain.MatchCollection{
+				{
+					ID:        "G1",
+					Timestamp: time.Date(2018, 5, 26, 14, 0, 0, 0, time.UTC),
+					Stage:     domain.GroupStage,
+					Home: domain.MatchCompetitor{
+						Team:  &domain.Team{ID: "AFC"},
+						Goals: 1,
+					},
+					Away: domain.MatchCompetitor{
+						Team: &domain.Team{ID: "BFC"},
+					},
+					Winner:    &domain.Team{ID: "AFC"},
+					Completed: true,
+				},
+				{
+					ID:        "G2",
+					Timestamp: time.Date(2018, 5, 27, 14, 0, 0, 0, time.UTC),
+					Stage:     domain.GroupStage,
+					Home: domain.MatchCompetitor{
+						Team: &domain.Team{ID: "BFC"},
+					},
+					Away: domain.MatchCompetitor{
+						Team: &domain.Team{ID: "CFC"},
+					},
+				},
+			},
+		},
+		{
+			name:    "glob matching files with a shared match id must produce the expected error",
+			pattern: "*_1.csv",
+			wantErr: newMultiError([]string{
+				`index 1: id 'G1': is duplicate`,
+			}),
+		},
+		{
+			name:    "glob matching no files must produce the expected error",
+			pattern: "non-existent_*.csv",
+			wantErr: fmt.Errorf("glob '%s': no files match: %w", filepath.Join(testdataDir, matchesDir, "glob", "non-existent_*.csv"), domain.ErrNotFound),
+		},
+		{
+			name:    "malformed glob must produce the expected error",
+			pattern: "matches_[.csv",
+			wantErr: fmt.Errorf("glob '%s': %w", filepath.Join(testdataDir, matchesDir, "glob", "matches_[.csv"), filepath.ErrBadPattern),
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			loader := newMatchesCSVLoader("").
+				WithGlob(filepath.Join(testdataDir, matchesDir, "glob", tc.pattern))
+			gotMatches, gotErr := loader.LoadMatches(nil)
+
+			cmpError(t, tc.wantErr, gotErr)
+			cmpDiff(t, tc.wantMatches, gotMatches)
+		})
+	}
+}
+
+func TestMatchesCSVLoader_LoadMatches_CompletedValues(t *testing.T) {
+	tt := []struct {
+		name          string
+		testFile      string
+		strict        bool
+		wantCompleted map[string]bool
+		wantErr       error
+	}{
+		{
+			name:     "truthy completed values must be parsed as completed regardless of case",
+			testFile: "matches_rows_with_varied_completed.csv",
+			wantCompleted: map[string]bool{
+				"A1": true,  // TRUE
+				"A2": true,  // 1
+				"A3": true,  // yes
+				"A4": true,  // y
+				"A5": false, // N
+				"A6": false, // false
+				"A7": false, // 0
+				"A8": false, // empty
+			},
+		},
+		{
+			name:     "truthy and falsy completed values must be parsed successfully in strict mode",
+			testFile: "matches_rows_with_varied_completed.csv",
+			strict:   true,
+			wantCompleted: map[string]bool{
+				"A1": true,
+				"A2": true,
+				"A3": true,
+				"A4": true,
+				"A5": false,
+				"A6": false,
+				"A7": false,
+				"A8": false,
+			},
+		},
+		{
+			name:     "ambiguous completed values must be parsed as not completed",
+			testFile: "matches_rows_with_ambiguous_completed.csv",
+			wantCompleted: map[string]bool{
+				"A1": true,
+				"A2": false,
+				"A3": false,
+			},
+		},
+		{
+			name:     "ambiguous completed values must produce the expected error in strict mode",
+			testFile: "matches_rows_with_ambiguous_completed.csv",
+			strict:   true,
+			wantErr: fmt.Errorf("cannot transform csv: %w", newMultiError([]string{
+				"row 2: invalid completed value: maybe",
+				"row 3: invalid completed value: 2",
+			})),
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			loader := newMatchesCSVLoader(tc.testFile).WithStrictCompleted(tc.strict)
+			gotMatches, gotErr := loader.LoadMatches(nil)
+			cmpError(t, tc.wantErr, gotErr)
+
+			var gotCompleted map[string]bool
+			for _, match := range gotMatches {
+				if gotCompleted == nil {
+					gotCompleted = make(map[string]bool)
+				}
+				gotCompleted[match.ID] = match.Completed
+			}
+
+			cmpDiff(t, tc.wantCompleted, gotCompleted)
+		})
+	}
+}
+
+func TestMatchesCSVLoader_LoadMatches_WithDerivedWinners(t *testing.T) {
+	tt := []struct {
+		name        string
+		testFile    string
+		derive      bool
+		wantMatches domain.MatchCollection
+		wantErr     error
+	}{
+		{
+			name:     "file without winner column must derive winners from scoreline",
+			testFile: "matches_without_winner_column.csv",
+			derive:   true,
+			wantMatches: domain.MatchCollection{
+				{
+					ID:        "A1",
+					Timestamp: time.Date(2018, 5, 26, 14, 0, 0, 0, time.UTC),
+					Stage:     domain.GroupStage,
+					Home:      domain.MatchCompetitor{Team: &domain.Team{ID: "STHFC"}, Goals: 2},
+					Away:      domain.MatchCompetitor{Team: &domain.Team{ID: "PTFC"}},
+					Winner:    &domain.Team{ID: "STHFC"}, // home win
+					Completed: true,
+				},
+				{
+					ID:        "A2",
+					Timestamp: time.Date(2018, 5, 26, 16, 0, 0, 0, time.UTC),
+					Stage:     domain.GroupStage,
+					Home:      domain.MatchCompetitor{Team: &domain.Team{ID: "DTFC"}},
+					Away:      domain.MatchCompetitor{Team: &domain.Team{ID: "BPFC"}, Goals: 1},
+					Winner:    &domain.Team{ID: "BPFC"}, // away win
+					Completed: true,
+				},
+				{
+					ID:        "A3",
+					Timestamp: time.Date(2018, 5, 27, 14, 0, 0, 0, time.UTC),
+					Stage:     domain.GroupStage,
+					Home:      domain.MatchCompetitor{Team: &domain.Team{ID: "WTFC"}, Goals: 1},
+					Away:      domain.MatchCompetitor{Team: &domain.Team{ID: "HUFC"}, Goals: 1},
+					Completed: true,
+					// draw, no winner
+				},
+				{
+					ID:                 "F",
+					Timestamp:          time.Date(2018, 5, 28, 14, 0, 0, 0, time.UTC),
+					Stage:              domain.KnockoutStage,
+					Home:               domain.MatchCompetitor{Team: &domain.Team{ID: "STHFC"}, Goals: 1},
+					Away:               domain.MatchCompetitor{Team: &domain.Team{ID: "BPFC"}, Goals: 1},
+					Winner:             &domain.Team{ID: "BPFC"}, // won on penalties
+					Completed:          true,
+					DecidedOnPenalties: true,
+					HomePens:           3,
+					AwayPens:           4,
+				},
+				{
+					ID:        "SF1",
+					Timestamp: time.Date(2018, 5, 29, 14, 0, 0, 0, time.UTC),
+					Stage:     domain.KnockoutStage,
+					Home:      domain.MatchCompetitor{Team: &domain.Team{ID: "PTFC"}},
+					Away:      domain.MatchCompetitor{Team: &domain.Team{ID: "DTFC"}},
+					// not completed, no winner
+				},
+			},
+		},
+		{
+			name:     "winner column that conflicts with scoreline must produce the expected error",
+			testFile: "matches_rows_with_conflicting_winner.csv",
+			derive:   true,
+			wantErr: fmt.Errorf("cannot transform csv: %w", newMultiError([]string{
+				"row 1: winning team id PTFC conflicts with scoreline winner STHFC",
+				"row 3: winning team id STHFC conflicts with scoreline winner BPFC",
+			})),
+		},
+		{
+			name:     "file without winner column must produce the expected error by default",
+			testFile: "matches_without_winner_column.csv",
+			wantErr: fmt.Errorf("cannot transform csv: invalid headers: MATCH_ID,DATE,TIME,STAGE,COMPLETED,HOME_TEAM_ID,AWAY_TEAM_ID,HOME_GOALS,AWAY_GOALS,HOME_YELLOW_CARDS,AWAY_YELLOW_CARDS,HOME_OG,AWAY_OG,HOME_RED_CARDS,AWAY_RED_CARDS,PENALTIES,HOME_PENS,AWAY_PENS: %w", newMultiError([]string{
+				"header 'WINNER_TEAM_ID': not found",
+			})),
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			loader := newMatchesCSVLoader(tc.testFile).WithDerivedWinners(tc.derive)
+			gotMatches, gotErr := loader.LoadMatches(nil)
+
+			cmpError(t, tc.wantErr, gotErr)
+			cmpDiff(t, tc.wantMatches, gotMatches)
+		})
+	}
+}
+
+func TestMatchesCSVLoader_LoadMatches_WithHeaderMapping(t *testing.T) {
+	statsExportMapping := map[string]string{
+		"MATCH_ID":          "fixture_id",
+		"DATE":              "kickoff_date",
+		"TIME":              "kickoff_time",
+		"STAGE":             "stage",
+		"COMPLETED":         "completed",
+		"WINNER_TEAM_ID":    "winner_code",
+		"HOME_TEAM_ID":      "home_team_code",
+		"AWAY_TEAM_ID":      "away_team_code",
+		"HOME_GOALS":        "home_score",
+		"AWAY_GOALS":        "away_score",
+		"HOME_YELLOW_CARDS": "home_yellows",
+		"AWAY_YELLOW_CARDS": "away_yellows",
+		"HOME_OG":           "home_own_goals",
+		"AWAY_OG":           "away_own_goals",
+		"HOME_RED_CARDS":    "home_reds",
+		"AWAY_RED_CARDS":    "away_reds",
+		"ATTENDANCE":        "attendance",
+	}
+
+	// withMapping returns a copy of the stats export mapping with the provided overrides applied
+	withMapping := func(overrides map[string]string) map[string]string {
+		mapping := make(map[string]string)
+		for column, header := range statsExportMapping {
+			mapping[column] = header
+		}
+		for column, header := range overrides {
+			mapping[column] = header
+		}
+		return mapping
+	}
+
+	tt := []struct {
+		name        string
+		testFile    string
+		delimiter   rune
+		mapping     map[string]string
+		wantMatches domain.MatchCollection
+		wantErr     error
+	}{
+		{
+			name:      "tab-separated file with mapped headers must be loaded successfully, ignoring unrecognised headers",
+			testFile:  "matches_stats_export.tsv",
+			delimiter: '\t',
+			mapping:   statsExportMapping,
+			wantMatches: domain.MatchCollection{
+				{
+					ID:        "A1",
+					Timestamp: time.Date(2018, 5, 26, 14, 0, 0, 0, time.UTC),
+					Stage:     domain.GroupStage,
+					Home: domain.MatchCompetitor{
+						Team:     &domain.Team{ID: "STHFC"},
+						Goals:    2,
+						OwnGoals: []domain.MatchEvent{{Name: "O'Brien", Minute: 12}},
+						RedCards: []domain.MatchEvent{{Name: "Prichard", Minute: 22}},
+					},
+					Away: domain.MatchCompetitor{
+						Team:        &domain.Team{ID: "PTFC"},
+						YellowCards: 2,
+						OwnGoals:    []domain.MatchEvent{{Name: "Thiessen", Minute: 54}},
+					},
+					Winner:     &domain.Team{ID: "STHFC"},
+					Completed:  true,
+					Attendance: 9792,
+				},
+				{
+					ID:        "A2",
+					Timestamp: time.Date(2018, 5, 26, 19, 45, 0, 0, time.UTC),
+					Stage:     domain.GroupStage,
+					Home: domain.MatchCompetitor{
+						Team:        &domain.Team{ID: "BPFC"},
+						Goals:       1,
+						YellowCards: 2,
+					},
+					Away: domain.MatchCompetitor{
+						Team:     &domain.Team{ID: "HUFC"},
+						Goals:    1,
+						OwnGoals: []domain.MatchEvent{{Name: "Friend", Minute: 43}, {Name: "Jefferson", Minute: 89}},
+					},
+					Completed:  true,
+					Attendance: 5120,
+				},
+				{
+					ID:        "SF1",
+					Timestamp: time.Date(2018, 6, 1, 15, 0, 0, 0, time.UTC),
+					Stage:     domain.KnockoutStage,
+					Home:      domain.MatchCompetitor{Team: &domain.Team{ID: "PTFC"}},
+					Away:      domain.MatchCompetitor{Team: &domain.Team{ID: "DTFC"}},
+				},
+			},
+		},
+		{
+			name:      "tab-separated file with missing mapped headers must produce the expected error",
+			testFile:  "matches_stats_export.tsv",
+			delimiter: '\t',
+			mapping: withMapping(map[string]string{
+				"MATCH_ID":   "match_ref",
+				"HOME_GOALS": "home_goals",
+				"KICK_OFF":   "kickoff_time",
+			}),
+			wantErr: fmt.Errorf("cannot transform csv: invalid headers: %w", newMultiError([]string{
+				"mapped column 'KICK_OFF': not found",
+				"column 'MATCH_ID' (header 'match_ref'): not found",
+				"column 'HOME_GOALS' (header 'home_goals'): not found",
+			})),
+		},
+		{
+			name:     "tab-separated file without delimiter must produce the expected error",
+			testFile: "matches_stats_export.tsv",
+			mapping:  map[string]string{"MATCH_ID": "fixture_id"},
+			wantErr: fmt.Errorf("cannot transform csv: invalid headers: %w", newMultiError([]string{
+				"column 'MATCH_ID' (header 'fixture_id'): not found",
+				"column 'DATE' (header 'DATE'): not found",
+				"column 'TIME' (header 'TIME'): not found",
+				"column 'STAGE' (header 'STAGE'): not found",
+				"column 'COMPLETED' (header 'COMPLETED'): not found",
+				"column 'WINNER_TEAM_ID' (header 'WINNER_TEAM_ID'): not found",
+				"column 'HOME_TEAM_ID' (header 'HOME_TEAM_ID'): not found",
+				"column 'AWAY_TEAM_ID' (header 'AWAY_TEAM_ID'): not found",
+				"column 'HOME_GOALS' (header 'HOME_GOALS'): not found",
+				"column 'AWAY_GOALS' (header 'AWAY_GOALS'): not found",
+				"column 'HOME_YELLOW_CARDS' (header 'HOME_YELLOW_CARDS'): not found",
+				"column 'AWAY_YELLOW_CARDS' (header 'AWAY_YELLOW_CARDS'): not found",
+				"column 'HOME_OG' (header 'HOME_OG'): not found",
+				"column 'AWAY_OG' (header 'AWAY_OG'): not found",
+				"column 'HOME_RED_CARDS' (header 'HOME_RED_CARDS'): not found",
+				"column 'AWAY_RED_CARDS' (header 'AWAY_RED_CARDS'): not found",
+			})),
+		},
+		{
+			name:      "file with duplicate mapped header must produce the expected error",
+			testFile:  "matches_stats_export.tsv",
+			delimiter: '\t',
+			mapping:   withMapping(map[string]string{"AWAY_GOALS": "home_score"}),
+			wantErr: fmt.Errorf("cannot transform csv: invalid headers: %w", newMultiError([]string{
+				"column 'AWAY_GOALS' (header 'home_score'): is duplicate",
+			})),
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			loader := newMatchesCSVLoader(tc.testFile).WithDelimiter(tc.delimiter).WithHeaderMapping(tc.mapping)
+			gotMatches, gotErr := loader.LoadMatches(nil)
+
+			cmpError(t, tc.wantErr, gotErr)
+			cmpDiff(t, tc.wantMatches, gotMatches)
+		})
+	}
+}
+
+func TestMatchesCSVLoader_LoadMatches_WithCombinedTimestamp(t *testing.T) {
+	tt := []struct {
+		name        string
+		testFile    string
+		combined    bool
+		wantMatches domain.MatchCollection
+		wantErr     error
+	}{
+		{
+			name:     "file with combined timestamp column must be loaded successfully",
+			testFile: "matches_with_combined_timestamp.csv",
+			combined: true,
+			wantMatches: domain.MatchCollection{
+				{
+					ID:        "A1",
+					Timestamp: time.Date(2018, 5, 26, 14, 0, 0, 0, time.UTC),
+					Stage:     domain.GroupStage,
+					Home: domain.MatchCompetitor{
+						Team:  &domain.Team{ID: "STHFC"},
+						Goals: 2,
+					},
+					Away: domain.MatchCompetitor{
+						Team:        &domain.Team{ID: "PTFC"},
+						YellowCards: 2,
+					},
+					Winner:    &domain.Team{ID: "STHFC"},
+					Completed: true,
+				},
+				{
+					ID:        "A2",
+					Timestamp: time.Date(2018, 5, 27, 18, 30, 0, 0, time.UTC),
+					Stage:     domain.GroupStage,
+					Home: domain.MatchCompetitor{
+						Team: &domain.Team{ID: "DTFC"},
+					},
+					Away: domain.MatchCompetitor{
+						Team: &domain.Team{ID: "BPFC"},
+					},
+				},
+			},
+		},
+		{
+			name:     "file with invalid combined timestamps must produce the expected error",
+			testFile: "matches_with_invalid_combined_timestamp.csv",
+			combined: true,
+			wantErr: fmt.Errorf("cannot transform csv: %w", newMultiError([]string{
+				"row 1: invalid timestamp format: 26/05/2018 14:00",
+				"row 2: invalid timestamp format: 2018-05-27 19:30:00",
+			})),
+		},
+		{
+			name:     "file with separate date and time columns must produce the expected error when combined",
+			testFile: "matches_ok.csv",
+			combined: true,
+			wantErr: fmt.Errorf("cannot transform csv: invalid headers: MATCH_ID,DATE,TIME,STAGE,COMPLETED,WINNER_TEAM_ID,HOME_TEAM_ID,AWAY_TEAM_ID,HOME_GOALS,AWAY_GOALS,HOME_YELLOW_CARDS,AWAY_YELLOW_CARDS,HOME_OG,AWAY_OG,HOME_RED_CARDS,AWAY_RED_CARDS,NOTES: %w", newMultiError([]string{
+				"header 'DATE': unrecognised",
+				"header 'TIME': unrecognised",
+				"header 'TIMESTAMP': not found",
+			})),
+		},
+		{
+			name:     "file with combined timestamp column must produce the expected error by default",
+			testFile: "matches_with_combined_timestamp.csv",
+			wantErr: fmt.Errorf("cannot transform csv: invalid headers: MATCH_ID,TIMESTAMP,STAGE,COMPLETED,WINNER_TEAM_ID,HOME_TEAM_ID,AWAY_TEAM_ID,HOME_GOALS,AWAY_GOALS,HOME_YELLOW_CARDS,AWAY_YELLOW_CARDS,HOME_OG,AWAY_OG,HOME_RED_CARDS,AWAY_RED_CARDS,NOTES: %w", newMultiError([]string{
+				"header 'TIMESTAMP': unrecognised",
+				"header 'DATE': not found",
+				"header 'TIME': not found",
+			})),
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			loader := newMatchesCSVLoader(tc.testFile).WithCombinedTimestamp(tc.combined)
+			gotMatches, gotErr := loader.LoadMatches(nil)
+
+			cmpError(t, tc.wantErr, gotErr)
+			cmpDiff(t, tc.wantMatches, gotMatches)
+		})
+	}
+}
+
+func TestMatchesCSVLoader_LoadMatches_WithCompletedAsOf(t *testing.T) {
+	asOf := &fakeClock{Timestamp: time.Date(2018, 5, 27, 12, 0, 0, 0, time.UTC)}
+
+	tt := []struct {
+		name          string
+		clock         domain.Clock
+		wantCompleted map[string]bool
+	}{
+		{
+			name:  "matches with omitted completed value must be completed if they kick off before the clock",
+			clock: asOf,
+			wantCompleted: map[string]bool{
+				"A1": true,  // omitted, in the past
+				"A2": false, // explicitly not completed, in the past
+				"A3": false, // omitted, in the future
+				"A4": true,  // explicitly completed, in the future
+			},
+		},
+		{
+			name: "matches with omitted completed value must not be completed by default",
+			wantCompleted: map[string]bool{
+				"A1": false,
+				"A2": false,
+				"A3": false,
+				"A4": true,
+			},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			loader := newMatchesCSVLoader("matches_rows_with_omitted_completed.csv").WithCompletedAsOf(tc.clock)
+			gotMatches, gotErr := loader.LoadMatches(nil)
+			cmpError(t, nil, gotErr)
+
+			gotCompleted := make(map[string]bool)
+			for _, match := range gotMatches {
+				gotCompleted[match.ID] = match.Completed
+			}
+			cmpDiff(t, tc.wantCompleted, gotCompleted)
+		})
+	}
+}
+
 func newMatchesCSVLoader(path string) *domain.MatchesCSVLoader {
 	if path != "" {
 		path = filepath.Join(testdataDir, matchesDir, path)
@@ -1,37 +1,136 @@
 package domain
 
 import (
+	"bytes"
 	"fmt"
 	"sort"
+	"text/template"
 	"time"
 )
 
 const (
-	// finalMatchID defines the id of the match considered to be the final
-	finalMatchID       = "F"
-	mostGoalsConceded  = "Most Goals Conceded"
-	mostYellowCards    = "Most Yellow Cards"
-	quickestOwnGoal    = "Quickest Own Goal"
-	quickestRedCard    = "Quickest Red Card"
-	tournamentRunnerUp = "Tournament Runner-Up"
-	tournamentWinner   = "Tournament Winner"
+	// defaultSummaryFormat defines the format used to summarise a participant (first verb) and their team (second verb)
+	defaultSummaryFormat = "%s (%s)"
+	// defaultFinalMatchID defines the id of the match considered to be the final, unless the tournament specifies otherwise
+	defaultFinalMatchID  = "F"
+	entertainers         = "Entertainers"
+	firstEliminated      = "First Eliminated"
+	goalRush             = "Goal Rush"
+	longestDefensiveRun  = "Longest Defensive Run"
+	longestWinningStreak = "Longest Winning Streak"
+	mostAssists          = "Most Assists"
+	mostComebackWins     = "Most Comeback Wins"
+	mostConcededInMatch  = "Most Conceded In A Match"
+	mostDifferentScorers = "Most Different Scorers"
+	mostGoalsConceded    = "Most Goals Conceded"
+	mostGoalsInKnockouts = "Most Goals In Knockouts"
+	mostYellowCards      = "Most Yellow Cards"
+	overallStandings     = "Overall Standings"
+	quickestHatTrick     = "Quickest Hat-Trick"
+	quickestOwnGoal      = "Quickest Own Goal"
+	quickestRedCard      = "Quickest Red Card"
+	tournamentRunnerUp   = "Tournament Runner-Up"
+	tournamentWinner     = "Tournament Winner"
+	woodenSpoon          = "Wooden Spoon"
 )
 
+// defaultHighScoringGoals defines the total goals at which a match is considered to be high-scoring, unless the tournament specifies otherwise
+const defaultHighScoringGoals = 4
+
+// defaultUnclaimedLabel defines the label that is summarised in place of the participant's name for a team that a sweepstake declares as unclaimed,
+// unless the tournament specifies otherwise
+const defaultUnclaimedLabel = "Unclaimed"
+
+// unresolvedParticipantName defines the participant name of an outright prize that cannot yet be determined
+const unresolvedParticipantName = "TBC"
+
 // OutrightPrize represents a prize with a single outright winner
 type OutrightPrize struct {
-	PrizeName       string
-	ParticipantName string
-	ImageURL        string
+	PrizeName       string `json:"prize_name"`
+	ParticipantName string `json:"participant_name"`
+	ImageURL        string `json:"image_url"`
+
+	team *Team     // team that has won the prize, if resolved
+	at   time.Time // time at which the team won the prize, which determines the participant who is assigned the team (zero if current)
+}
+
+// IsResolved returns true if the prize has been determined, so that templates can omit prizes that are yet to be decided
+func (o *OutrightPrize) IsResolved() bool {
+	return o != nil && o.ParticipantName != unresolvedParticipantName
 }
 
 // OutrightPrizeGenerator defines a function that generates an outright prize from the provided Sweepstake
 type OutrightPrizeGenerator func(sweepstake *Sweepstake) *OutrightPrize
 
+// RankedPrizeGenerator defines a function that generates a ranked prize from the provided Sweepstake
+type RankedPrizeGenerator func(sweepstake *Sweepstake) *RankedPrize
+
+// RankOrder determines whether a ranked prize ranks the highest or the lowest values first
+type RankOrder uint8
+
+const (
+	RankDescending RankOrder = iota // highest value first (e.g. most goals)
+	RankAscending                   // lowest value first (e.g. quickest match minute)
+)
+
+// NewRankedPrizeGenerator returns a generator of the ranked prize with the provided name, which ranks the teams whose values are extracted
+// from the Sweepstake by valuesFn in the provided order
+//
+// Teams with an identical value retain the order in which their values are extracted, so valuesFn is responsible for any further tie-break.
+// Each value is rendered by the value template of the provided prize key (see Tournament.ValueTemplates). A team whose value is below the
+// sweepstake's minimum value for the provided prize key (see Sweepstake.MinimumValues) does not rank, if the prize is ranked in descending order
+func NewRankedPrizeGenerator(name, key string, order RankOrder, valuesFn func(s *Sweepstake) []RankValue) RankedPrizeGenerator {
+	return func(s *Sweepstake) *RankedPrize {
+		prize := &RankedPrize{
+			PrizeName: name,
+			Rankings:  make([]Rank, 0),
+		}
+
+		if s == nil {
+			return prize
+		}
+
+		minValue := s.MinimumValues[key]
+		if order != RankDescending {
+			minValue = 0 // a minimum is meaningless for the match minute of an event, which is restricted by MinuteBounds instead
+		}
+
+		values := valuesFn(s)
+		sort.SliceStable(values, func(i, j int) bool {
+			if order == RankAscending {
+				return values[i].Value < values[j].Value
+			}
+			return values[i].Value > values[j].Value
+		})
+
+		for _, value := range values {
+			if value.Team == nil {
+				continue // value cannot be attributed to a team that is not yet known
+			}
+
+			if value.Value < minValue {
+				continue // value does not meet the minimum to rank
+			}
+
+			prize.Rankings = append(prize.Rankings, Rank{
+				Position:        uint8(len(prize.Rankings) + 1),
+				ImageURL:        value.Team.ImageURL,
+				ParticipantName: s.summaryForAt(value.Team, value.at),
+				Value:           s.formatRankValue(key, value),
+				team:            value.Team,
+				at:              value.at,
+			})
+		}
+
+		return prize
+	}
+}
+
 // TournamentWinner determines the winner of the provided Sweepstake
 var TournamentWinner = func(s *Sweepstake) *OutrightPrize {
 	defaultPrize := &OutrightPrize{
 		PrizeName:       tournamentWinner,
-		ParticipantName: "TBC",
+		ParticipantName: unresolvedParticipantName,
 	}
 
 	if s == nil {
@@ -39,35 +138,44 @@ var TournamentWinner = func(s *Sweepstake) *OutrightPrize {
 	}
 
 	// get match winner
-	winningTeam := s.Tournament.Matches.GetWinnerByMatchID(finalMatchID)
+	final := s.Tournament.FinalMatch()
+	if final == nil || final.ExcludeFromPrizes {
+		return defaultPrize
+	}
+
+	winningTeam, _, _ := final.Outcome()
 	if winningTeam == nil {
 		return defaultPrize
 	}
 
 	// get participant who represents the match winner
-	participant := s.Participants.GetByTeamID(winningTeam.ID)
-	winnerName := getSummaryFromTeamAndParticipant(winningTeam, participant)
+	winnerName := s.summaryForAt(winningTeam, final.Timestamp)
 
 	return &OutrightPrize{
 		PrizeName:       tournamentWinner,
 		ParticipantName: winnerName,
 		ImageURL:        winningTeam.ImageURL,
+		team:            winningTeam,
+		at:              final.Timestamp,
 	}
 }
 
-func getSummaryFromTeamAndParticipant(team *Team, participant *Participant) string {
-	if participant == nil || participant.Name == "" {
-		return team.Name
+// getSummaryFromTeamAndParticipant returns the summary of the provided participant alongside their team, according to the tournament's summary format
+//
+// If the team has no participant and the tournament has an unclaimed label, the label is summarised in place of the participant's name
+func getSummaryFromTeamAndParticipant(tournament *Tournament, team *Team, participant *Participant) string {
+	if participant == nil && tournament.UnclaimedLabel != "" {
+		return (&Participant{Name: tournament.UnclaimedLabel}).DisplayName(team, tournament.SummaryFormat)
 	}
 
-	return fmt.Sprintf("%s (%s)", participant.Name, team.Name)
+	return participant.DisplayName(team, tournament.SummaryFormat)
 }
 
 // TournamentRunnerUp determines the runner-up of the provided Sweepstake
 var TournamentRunnerUp = func(s *Sweepstake) *OutrightPrize {
 	defaultPrize := &OutrightPrize{
 		PrizeName:       tournamentRunnerUp,
-		ParticipantName: "TBC",
+		ParticipantName: unresolvedParticipantName,
 	}
 
 	if s == nil {
@@ -75,157 +183,735 @@ var TournamentRunnerUp = func(s *Sweepstake) *OutrightPrize {
 	}
 
 	// get match runner-up
-	runnerUpTeam := s.Tournament.Matches.GetRunnerUpByMatchID(finalMatchID)
+	final := s.Tournament.FinalMatch()
+	if final == nil || final.ExcludeFromPrizes {
+		return defaultPrize
+	}
+
+	_, runnerUpTeam, _ := final.Outcome()
 	if runnerUpTeam == nil {
 		return defaultPrize
 	}
 
 	// get participant who represents the match runner-up
-	participant := s.Participants.GetByTeamID(runnerUpTeam.ID)
-	participantSummary := getSummaryFromTeamAndParticipant(runnerUpTeam, participant)
+	participantSummary := s.summaryForAt(runnerUpTeam, final.Timestamp)
 
 	return &OutrightPrize{
 		PrizeName:       tournamentRunnerUp,
 		ParticipantName: participantSummary,
 		ImageURL:        runnerUpTeam.ImageURL,
+		team:            runnerUpTeam,
+		at:              final.Timestamp,
 	}
 }
 
-// MostGoalsConceded returns the teams who have conceded the most goals in descending order
-var MostGoalsConceded = func(s *Sweepstake) *RankedPrize {
-	defaultPrize := &RankedPrize{
-		PrizeName: mostGoalsConceded,
-		Rankings:  make([]Rank, 0),
+// WoodenSpoon determines the worst-performing team of the provided Sweepstake
+//
+// The team with the fewest points across all completed matches wins the prize, with ties broken by the worst goal difference, then the most goals conceded
+var WoodenSpoon = func(s *Sweepstake) *OutrightPrize {
+	defaultPrize := &OutrightPrize{
+		PrizeName:       woodenSpoon,
+		ParticipantName: unresolvedParticipantName,
 	}
 
 	if s == nil {
 		return defaultPrize
 	}
 
-	totals := teamsAudit{teams: s.Tournament.Teams}
+	records := getTeamRecords(s.Tournament.Teams, s.Tournament.Matches.FilterForPrizes())
+	if len(records) == 0 {
+		return defaultPrize
+	}
 
-	for _, match := range s.Tournament.Matches {
-		if !match.Completed {
+	sort.SliceStable(records, func(i, j int) bool {
+		switch {
+		case records[i].points != records[j].points:
+			return records[i].points < records[j].points
+		case records[i].goalDifference() != records[j].goalDifference():
+			return records[i].goalDifference() < records[j].goalDifference()
+		default:
+			return records[i].goalsAgainst > records[j].goalsAgainst
+		}
+	})
+
+	// get participant who represents the worst-performing team
+	worstTeam := records[0].team
+	participantSummary := s.SummaryFor(worstTeam)
+
+	return &OutrightPrize{
+		PrizeName:       woodenSpoon,
+		ParticipantName: participantSummary,
+		ImageURL:        worstTeam.ImageURL,
+		team:            worstTeam,
+	}
+}
+
+// FirstEliminated determines the first team of the provided Sweepstake to be knocked out during the knockout stage
+//
+// The losing team of the earliest completed knockout match wins the prize, with matches that kick off at the same time ordered by match id
+var FirstEliminated = func(s *Sweepstake) *OutrightPrize {
+	defaultPrize := &OutrightPrize{
+		PrizeName:       firstEliminated,
+		ParticipantName: unresolvedParticipantName,
+	}
+
+	if s == nil {
+		return defaultPrize
+	}
+
+	// get earliest knockout match that has a loser
+	var earliest *Match
+	for _, match := range s.Tournament.Matches.FilterForPrizes().FilterByStage(KnockoutStage) {
+		if _, loser, _ := match.Outcome(); loser == nil {
 			continue
 		}
 
-		totals.inc(match.Home.Team, int(match.Away.Goals)) // goals scored by away team are conceded by home team
-		totals.inc(match.Away.Team, int(match.Home.Goals)) // goals scored by home team are conceded by away team
+		if earliest == nil ||
+			match.Timestamp.Before(earliest.Timestamp) ||
+			(match.Timestamp.Equal(earliest.Timestamp) && match.ID < earliest.ID) {
+			earliest = match
+		}
 	}
 
-	return &RankedPrize{
-		PrizeName: mostGoalsConceded,
-		Rankings:  getPrizeRankingsFromAudit("⚽", totals, s.Participants),
+	if earliest == nil {
+		return defaultPrize
 	}
+
+	// get participant who represents the eliminated team
+	_, eliminatedTeam, _ := earliest.Outcome()
+	participantSummary := s.summaryForAt(eliminatedTeam, earliest.Timestamp)
+
+	return &OutrightPrize{
+		PrizeName:       firstEliminated,
+		ParticipantName: participantSummary,
+		ImageURL:        eliminatedTeam.ImageURL,
+		team:            eliminatedTeam,
+		at:              earliest.Timestamp,
+	}
+}
+
+// teamRecord represents the cumulative results of a team across its completed matches
+type teamRecord struct {
+	team         *Team
+	points       int
+	goalsFor     int
+	goalsAgainst int
 }
 
-func getPrizeRankingsFromAudit(prefix string, audit teamsAudit, participants ParticipantCollection) []Rank {
-	type teamWithValue struct {
-		team  *Team
-		value int
+func (t teamRecord) goalDifference() int {
+	return t.goalsFor - t.goalsAgainst
+}
+
+// getTeamRecords returns the record of each of the provided teams that has played at least one completed match, in order of the provided teams
+func getTeamRecords(teams TeamCollection, matches MatchCollection) []teamRecord {
+	records := make([]teamRecord, 0)
+
+	for _, team := range teams {
+		record := teamRecord{team: team}
+		var played bool
+
+		for _, match := range matches {
+			opponent := match.OpponentOf(team)
+			if !match.Completed || opponent == nil {
+				continue
+			}
+
+			goalsFor := match.Home.Goals
+			if opponent == &match.Home { // provided team is the away team
+				goalsFor = match.Away.Goals
+			}
+
+			played = true
+			record.goalsFor += int(goalsFor)
+			record.goalsAgainst += int(opponent.Goals)
+		}
+
+		if played {
+			record.points = matches.PointsFor(team)
+			records = append(records, record)
+		}
 	}
 
-	results := make([]teamWithValue, 0)
+	return records
+}
+
+// MostGoalsConceded returns the teams who have conceded the most goals in descending order
+var MostGoalsConceded = NewRankedPrizeGenerator(mostGoalsConceded, "most_goals_conceded", RankDescending, func(s *Sweepstake) []RankValue {
+	totals := s.Tournament.cachedAudit("most_goals_conceded", func() teamsAudit {
+		totals := teamsAudit{teams: s.Tournament.Teams}
+
+		for _, match := range s.Tournament.Matches.FilterForPrizes() {
+			if !match.Completed {
+				continue
+			}
+
+			totals.inc(match.Home.Team, int(match.Away.Goals)) // goals scored by away team are conceded by home team
+			totals.inc(match.Away.Team, int(match.Home.Goals)) // goals scored by home team are conceded by away team
+		}
+
+		return totals
+	})
+
+	return getRankValuesFromAudit(totals)
+})
+
+// MostGoalsInKnockouts returns the teams who have scored the most goals during the knockout stage in descending order
+var MostGoalsInKnockouts = NewRankedPrizeGenerator(mostGoalsInKnockouts, "most_goals_knockouts", RankDescending, func(s *Sweepstake) []RankValue {
+	totals := s.Tournament.cachedAudit("most_goals_knockouts", func() teamsAudit {
+		totals := teamsAudit{teams: s.Tournament.Teams}
+
+		for _, match := range s.Tournament.Matches.FilterForPrizes().FilterByStage(KnockoutStage) {
+			if !match.Completed {
+				continue
+			}
+
+			totals.inc(match.Home.Team, int(match.Home.Goals))
+			totals.inc(match.Away.Team, int(match.Away.Goals))
+		}
+
+		return totals
+	})
+
+	return getRankValuesFromAudit(totals)
+})
+
+// cachedAudit returns the tournament's audit of the provided prize key, computing it via auditFn if it has not yet been cached
+//
+// The team-level tallies of a tournament are shared by all of its sweepstakes, so only the participants are resolved per sweepstake.
+// The cached audits are therefore stale if the tournament's teams or matches change, unless InvalidatePrizeAudits is called
+func (t *Tournament) cachedAudit(key string, auditFn func() teamsAudit) teamsAudit {
+	if t == nil {
+		return auditFn()
+	}
+
+	t.auditsMu.Lock()
+	defer t.auditsMu.Unlock()
+
+	if audit, ok := t.audits[key]; ok {
+		return audit
+	}
+
+	if t.audits == nil {
+		t.audits = make(map[string]teamsAudit)
+	}
+
+	audit := auditFn()
+	t.audits[key] = audit
+
+	return audit
+}
+
+// InvalidatePrizeAudits discards the tallies that have been cached while generating the tournament's prizes, so that they are
+// recomputed from its current teams and matches
+func (t *Tournament) InvalidatePrizeAudits() {
+	t.auditsMu.Lock()
+	defer t.auditsMu.Unlock()
+
+	t.audits = nil
+}
 
-	for _, t := range audit.teams {
-		val, _ := audit.get(t)
-		results = append(results, teamWithValue{
-			team:  t,
-			value: val,
+// getRankValuesFromAudit returns the value of each team within the provided audit, in order of the audit's teams
+//
+// Teams without a value (i.e. zero) are omitted, since they are not eligible for the prize
+func getRankValuesFromAudit(audit teamsAudit) []RankValue {
+	values := make([]RankValue, 0)
+
+	for _, team := range audit.teams {
+		val, _ := audit.get(team)
+		if val == 0 {
+			continue
+		}
+
+		values = append(values, RankValue{
+			Team:  team,
+			Value: val,
 		})
 	}
 
-	sort.SliceStable(results, func(i, j int) bool {
-		return results[i].value > results[j].value
+	return values
+}
+
+// LongestWinningStreak returns the teams who have won the most consecutive matches in descending order
+var LongestWinningStreak = NewRankedPrizeGenerator(longestWinningStreak, "longest_winning_streak", RankDescending, func(s *Sweepstake) []RankValue {
+	streaks := s.Tournament.cachedAudit("longest_winning_streak", func() teamsAudit {
+		// audit teams in order of name, so that teams with an identical streak are ranked alphabetically
+		teams := make(TeamCollection, len(s.Tournament.Teams))
+		copy(teams, s.Tournament.Teams)
+		sort.SliceStable(teams, func(i, j int) bool {
+			return teams[i].Name < teams[j].Name
+		})
+
+		matches := s.Tournament.Matches.FilterForPrizes()
+
+		streaks := teamsAudit{teams: teams}
+		for _, team := range teams {
+			streaks.set(team, getLongestWinningStreak(team, matches))
+		}
+
+		return streaks
 	})
 
-	ranks := make([]Rank, 0)
+	return getRankValuesFromAudit(streaks)
+})
+
+// getLongestWinningStreak returns the longest run of consecutive completed matches won by the provided team, in order of kick-off
+func getLongestWinningStreak(team *Team, matches MatchCollection) int {
+	var played MatchCollection
+	for _, match := range matches {
+		if match.Completed && match.OpponentOf(team) != nil {
+			played = append(played, match)
+		}
+	}
+
+	sort.SliceStable(played, func(i, j int) bool {
+		return played[i].Timestamp.Before(played[j].Timestamp)
+	})
 
-	for idx, result := range results {
-		if result.value == 0 {
+	var longest, current int
+	for _, match := range played {
+		if !match.isWonBy(team) {
+			current = 0
 			continue
 		}
 
-		ranks = append(ranks, Rank{
-			Position:        uint8(idx + 1),
-			ImageURL:        result.team.ImageURL,
-			ParticipantName: getSummaryFromTeamAndParticipant(result.team, participants.GetByTeamID(result.team.ID)),
-			Value:           fmt.Sprintf("%s️ %d", prefix, result.value),
+		current++
+		if current > longest {
+			longest = current
+		}
+	}
+
+	return longest
+}
+
+// LongestDefensiveRun returns the teams who have played the most consecutive matches without conceding a goal in descending order
+var LongestDefensiveRun = NewRankedPrizeGenerator(longestDefensiveRun, "longest_defensive_run", RankDescending, func(s *Sweepstake) []RankValue {
+	runs := s.Tournament.cachedAudit("longest_defensive_run", func() teamsAudit {
+		// audit teams in order of name, so that teams with an identical run are ranked alphabetically
+		teams := make(TeamCollection, len(s.Tournament.Teams))
+		copy(teams, s.Tournament.Teams)
+		sort.SliceStable(teams, func(i, j int) bool {
+			return teams[i].Name < teams[j].Name
 		})
+
+		matches := s.Tournament.Matches.FilterForPrizes()
+
+		runs := teamsAudit{teams: teams}
+		for _, team := range teams {
+			runs.set(team, getLongestDefensiveRun(team, matches))
+		}
+
+		return runs
+	})
+
+	return getRankValuesFromAudit(runs)
+})
+
+// getLongestDefensiveRun returns the longest run of consecutive completed matches in which the provided team did not concede a goal, in order of kick-off
+//
+// Goals conceded in a penalty shoot-out do not count, since they do not form part of the match's score
+func getLongestDefensiveRun(team *Team, matches MatchCollection) int {
+	var played MatchCollection
+	for _, match := range matches {
+		if match.Completed && match.OpponentOf(team) != nil {
+			played = append(played, match)
+		}
 	}
 
-	return ranks
+	sort.SliceStable(played, func(i, j int) bool {
+		return played[i].Timestamp.Before(played[j].Timestamp)
+	})
+
+	var longest, current int
+	for _, match := range played {
+		if match.OpponentOf(team).Goals > 0 {
+			current = 0
+			continue
+		}
+
+		current++
+		if current > longest {
+			longest = current
+		}
+	}
+
+	return longest
 }
 
-// MostYellowCards returns the teams who have received the most yellow cards in descending order
-var MostYellowCards = func(s *Sweepstake) *RankedPrize {
-	defaultPrize := &RankedPrize{
-		PrizeName: mostYellowCards,
-		Rankings:  make([]Rank, 0),
+// MostComebackWins returns the teams who have won the most matches after trailing at some point in descending order
+//
+// Only matches whose goal events (scorers and own goals) account for every goal are considered, since the running score cannot otherwise be determined
+var MostComebackWins = NewRankedPrizeGenerator(mostComebackWins, "most_comeback_wins", RankDescending, func(s *Sweepstake) []RankValue {
+	totals := s.Tournament.cachedAudit("most_comeback_wins", func() teamsAudit {
+		totals := teamsAudit{teams: s.Tournament.Teams}
+
+		for _, match := range s.Tournament.Matches.FilterForPrizes() {
+			if !match.Completed {
+				continue
+			}
+
+			if team := getComebackWinner(match); team != nil {
+				totals.inc(team, 1)
+			}
+		}
+
+		return totals
+	})
+
+	return getRankValuesFromAudit(totals)
+})
+
+// getComebackWinner returns the team that won the provided match having trailed at some point, or nil if the match was not won from behind
+//
+// A match decided on penalties is not won from behind, and nil is also returned if the match's goal events do not account for every goal
+func getComebackWinner(match *Match) *Team {
+	if match.Home.Team == nil || match.Away.Team == nil || match.Home.Goals == match.Away.Goals {
+		return nil
 	}
 
-	if s == nil {
-		return defaultPrize
+	extractor := &matchEventsExtractor{match: match}
+	goals := extractor.scorers()
+
+	// an own goal counts towards the opponent of the team that scored it
+	for _, ev := range extractor.ownGoals() {
+		ev.For, ev.Against = ev.Against, ev.For
+		goals = append(goals, ev)
+	}
+
+	var homeEvents, awayEvents int
+	for _, ev := range goals {
+		if ev.For.ID == match.Home.Team.ID {
+			homeEvents++
+		} else {
+			awayEvents++
+		}
+	}
+
+	if homeEvents != int(match.Home.Goals) || awayEvents != int(match.Away.Goals) {
+		return nil // running score cannot be determined
+	}
+
+	sort.SliceStable(goals, func(i, j int) bool {
+		if goals[i].Minute != goals[j].Minute {
+			return goals[i].Minute < goals[j].Minute
+		}
+		return goals[i].Offset < goals[j].Offset
+	})
+
+	winner, _, _ := match.Outcome()
+	if winner == nil {
+		return nil
+	}
+
+	var winnerGoals, loserGoals int
+	for idx, ev := range goals {
+		if ev.For.ID == winner.ID {
+			winnerGoals++
+		} else {
+			loserGoals++
+		}
+
+		// goals at an identical minute (and offset) are applied together, since their order cannot be determined
+		if idx+1 < len(goals) && goals[idx+1].Minute == ev.Minute && goals[idx+1].Offset == ev.Offset {
+			continue
+		}
+
+		if winnerGoals < loserGoals {
+			return winner
+		}
 	}
 
-	totals := teamsAudit{teams: s.Tournament.Teams}
+	return nil
+}
+
+// GoalRush returns the teams who have scored the most goals within a single half of a match in descending order
+//
+// Each team is ranked by its best half, and only goals that are recorded as scorer events count towards the prize
+var GoalRush = NewRankedPrizeGenerator(goalRush, "goal_rush", RankDescending, func(s *Sweepstake) []RankValue {
+	bursts := make([]goalBurst, 0)
 
-	for _, match := range s.Tournament.Matches {
+	for _, match := range s.Tournament.Matches.FilterForPrizes() {
 		if !match.Completed {
 			continue
 		}
 
-		totals.inc(match.Home.Team, int(match.Home.YellowCards))
-		totals.inc(match.Away.Team, int(match.Away.YellowCards))
+		bursts = append(bursts, getGoalBursts(match)...)
 	}
 
-	return &RankedPrize{
-		PrizeName: mostYellowCards,
-		Rankings:  getPrizeRankingsFromAudit("🟨", totals, s.Participants),
+	return getRankValuesFromGoalBursts(bursts, s.Tournament)
+})
+
+// MostConcededInMatch returns the teams who have conceded the most goals within a single match in descending order
+//
+// Each team is ranked by its worst match, and a team that has not conceded a goal does not rank
+var MostConcededInMatch = NewRankedPrizeGenerator(mostConcededInMatch, "most_conceded_single_match", RankDescending, func(s *Sweepstake) []RankValue {
+	bursts := make([]goalBurst, 0)
+
+	for _, match := range s.Tournament.Matches.FilterForPrizes() {
+		if !match.Completed || match.Home.Team == nil || match.Away.Team == nil {
+			continue
+		}
+
+		for _, conceded := range []goalBurst{
+			{Goals: int(match.Away.Goals), Timestamp: match.Timestamp, For: match.Home.Team, Against: match.Away.Team},
+			{Goals: int(match.Home.Goals), Timestamp: match.Timestamp, For: match.Away.Team, Against: match.Home.Team},
+		} {
+			if conceded.Goals > 0 {
+				bursts = append(bursts, conceded)
+			}
+		}
 	}
+
+	return getRankValuesFromGoalBursts(bursts, s.Tournament)
+})
+
+// goalBurst represents the goals scored by a team within a single half of a match
+//
+// A burst without a half represents the goals conceded by a team within a whole match (see MostConcededInMatch)
+type goalBurst struct {
+	Half      string
+	Goals     int
+	Timestamp time.Time
+	For       *Team
+	Against   *Team
 }
 
-// QuickestOwnGoal returns the teams who have scored at least one own goal in ascending order of match minute
-var QuickestOwnGoal = func(s *Sweepstake) *RankedPrize {
-	defaultPrize := &RankedPrize{
-		PrizeName: quickestOwnGoal,
-		Rankings:  make([]Rank, 0),
+// getGoalBursts returns the goals scored by each of the provided match's competitors within each half, in order of the first goal of each half
+func getGoalBursts(match *Match) []goalBurst {
+	bursts := make([]goalBurst, 0)
+
+	for _, ev := range (&matchEventsExtractor{match: match}).scorers() {
+		half := getMatchHalf(ev.MatchEvent)
+
+		var found bool
+		for idx := range bursts {
+			if bursts[idx].For.ID == ev.For.ID && bursts[idx].Half == half {
+				bursts[idx].Goals++
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			bursts = append(bursts, goalBurst{
+				Half:      half,
+				Goals:     1,
+				Timestamp: ev.Timestamp,
+				For:       ev.For,
+				Against:   ev.Against,
+			})
+		}
 	}
 
-	if s == nil {
-		return defaultPrize
+	return bursts
+}
+
+// getMatchHalf returns the half of the match in which the provided event took place (H1 or H2, then ET1 or ET2 for extra-time)
+//
+// An event in stopped time belongs to the half that it extends (e.g. 45+2 belongs to H1)
+func getMatchHalf(ev MatchEvent) string {
+	switch {
+	case ev.Minute <= 45:
+		return "H1"
+	case ev.Minute <= 90:
+		return "H2"
+	case ev.Minute <= 105:
+		return "ET1"
+	default:
+		return "ET2"
+	}
+}
+
+// getRankValuesFromGoalBursts returns the best of the provided bursts for each team, in descending order of goals
+//
+// Bursts with identical goals are ordered by match timestamp (asc) then by team name (asc)
+func getRankValuesFromGoalBursts(bursts []goalBurst, tournament *Tournament) []RankValue {
+	sort.SliceStable(bursts, func(i, j int) bool {
+		switch {
+		case bursts[i].Goals != bursts[j].Goals:
+			return bursts[i].Goals > bursts[j].Goals
+		case !bursts[i].Timestamp.Equal(bursts[j].Timestamp):
+			return bursts[i].Timestamp.Before(bursts[j].Timestamp)
+		default:
+			return bursts[i].For.Name < bursts[j].For.Name
+		}
+	})
+
+	values := make([]RankValue, 0)
+	ranked := make(map[string]struct{})
+
+	for _, burst := range bursts {
+		// rank each team by its best burst only
+		if _, ok := ranked[burst.For.ID]; ok {
+			continue
+		}
+		ranked[burst.For.ID] = struct{}{}
+
+		values = append(values, RankValue{
+			Team:    burst.For,
+			Value:   burst.Goals,
+			Half:    burst.Half,
+			Against: burst.Against,
+			Date:    tournament.inLocation(burst.Timestamp).Format("02/01"),
+			at:      burst.Timestamp,
+		})
 	}
 
+	return values
+}
+
+// MostYellowCards returns the teams who have received the most yellow cards in descending order
+var MostYellowCards = NewRankedPrizeGenerator(mostYellowCards, "most_yellow_cards", RankDescending, func(s *Sweepstake) []RankValue {
+	totals := s.Tournament.cachedAudit("most_yellow_cards", func() teamsAudit {
+		totals := teamsAudit{teams: s.Tournament.Teams}
+
+		for _, match := range s.Tournament.Matches.FilterForPrizes() {
+			if !match.Completed {
+				continue
+			}
+
+			totals.inc(match.Home.Team, int(match.Home.YellowCards))
+			totals.inc(match.Away.Team, int(match.Away.YellowCards))
+		}
+
+		return totals
+	})
+
+	return getRankValuesFromAudit(totals)
+})
+
+// QuickestHatTrick returns the teams whose player has scored a hat-trick in ascending order of the match minute of the third goal
+//
+// A hat-trick is three goals scored by the same named player within a single match, so a player who scores more than three goals
+// is ranked by the minute of their third goal, and only goals that are recorded as scorer events count towards the prize
+var QuickestHatTrick = NewRankedPrizeGenerator(quickestHatTrick, "quickest_hat_trick", RankAscending, func(s *Sweepstake) []RankValue {
 	events := make([]matchEventWithTeams, 0)
 
-	for _, match := range s.Tournament.Matches {
+	for _, match := range s.Tournament.Matches.FilterForPrizes() {
 		if !match.Completed {
 			continue
 		}
 
-		events = append(events, (&matchEventsExtractor{match: match}).ownGoals()...)
+		events = append(events, getHatTrickGoals(match)...)
 	}
 
-	return &RankedPrize{
-		PrizeName: quickestOwnGoal,
-		Rankings:  getPrizeRankingsFromMatchEvents("🙈", events, s.Participants),
+	return getRankValuesFromMatchEvents(events, s.Tournament, s.MinuteBounds["quickest_hat_trick"])
+})
+
+// MostDifferentScorers returns the teams who have had the most different players score in descending order
+//
+// Only goals that are recorded as scorer events by a named player count towards the prize, so own goals are excluded
+var MostDifferentScorers = NewRankedPrizeGenerator(mostDifferentScorers, "most_different_scorers", RankDescending, func(s *Sweepstake) []RankValue {
+	totals := s.Tournament.cachedAudit("most_different_scorers", func() teamsAudit {
+		scorersByTeamID := make(map[string]map[string]struct{})
+
+		for _, match := range s.Tournament.Matches.FilterForPrizes() {
+			if !match.Completed {
+				continue
+			}
+
+			for _, ev := range (&matchEventsExtractor{match: match}).scorers() {
+				if ev.Name == "" {
+					continue // goals cannot be attributed to an unnamed player
+				}
+
+				if _, ok := scorersByTeamID[ev.For.ID]; !ok {
+					scorersByTeamID[ev.For.ID] = make(map[string]struct{})
+				}
+				scorersByTeamID[ev.For.ID][ev.Name] = struct{}{}
+			}
+		}
+
+		totals := teamsAudit{teams: s.Tournament.Teams}
+		for _, team := range s.Tournament.Teams {
+			totals.set(team, len(scorersByTeamID[team.ID]))
+		}
+
+		return totals
+	})
+
+	return getRankValuesFromAudit(totals)
+})
+
+// MostAssists returns the teams whose players have provided the most assists in descending order
+//
+// Only goals that are recorded with an assist count towards the prize, so its rankings remain empty unless the tournament's matches provide assist data
+var MostAssists = NewRankedPrizeGenerator(mostAssists, "most_assists", RankDescending, func(s *Sweepstake) []RankValue {
+	totals := s.Tournament.cachedAudit("most_assists", func() teamsAudit {
+		totals := teamsAudit{teams: s.Tournament.Teams}
+
+		for _, match := range s.Tournament.Matches.FilterForPrizes() {
+			if !match.Completed {
+				continue
+			}
+
+			for _, ev := range (&matchEventsExtractor{match: match}).scorers() {
+				if ev.Assist != "" {
+					totals.inc(ev.For, 1)
+				}
+			}
+		}
+
+		return totals
+	})
+
+	return getRankValuesFromAudit(totals)
+})
+
+// getHatTrickGoals returns the third goal of each named player who has scored at least three goals within the provided match
+func getHatTrickGoals(match *Match) []matchEventWithTeams {
+	goals := (&matchEventsExtractor{match: match}).scorers()
+
+	sort.SliceStable(goals, func(i, j int) bool {
+		if goals[i].Minute != goals[j].Minute {
+			return goals[i].Minute < goals[j].Minute
+		}
+		return goals[i].Offset < goals[j].Offset
+	})
+
+	type player struct {
+		teamID string
+		name   string
 	}
-}
 
-// QuickestRedCard returns the teams who have received at least one red card in ascending order of match minute
-var QuickestRedCard = func(s *Sweepstake) *RankedPrize {
-	defaultPrize := &RankedPrize{
-		PrizeName: quickestRedCard,
-		Rankings:  make([]Rank, 0),
+	counts := make(map[player]int)
+	hatTricks := make([]matchEventWithTeams, 0)
+
+	for _, ev := range goals {
+		if ev.Name == "" {
+			continue // goals cannot be attributed to an unnamed player
+		}
+
+		key := player{teamID: ev.For.ID, name: ev.Name}
+		counts[key]++
+
+		if counts[key] == 3 {
+			hatTricks = append(hatTricks, ev)
+		}
 	}
 
-	if s == nil {
-		return defaultPrize
+	return hatTricks
+}
+
+// QuickestOwnGoal returns the teams who have scored at least one own goal in ascending order of match minute
+var QuickestOwnGoal = NewRankedPrizeGenerator(quickestOwnGoal, "quickest_own_goal", RankAscending, func(s *Sweepstake) []RankValue {
+	events := make([]matchEventWithTeams, 0)
+
+	for _, match := range s.Tournament.Matches.FilterForPrizes() {
+		if !match.Completed {
+			continue
+		}
+
+		events = append(events, (&matchEventsExtractor{match: match}).ownGoals()...)
 	}
 
+	return getRankValuesFromMatchEvents(events, s.Tournament, s.MinuteBounds["quickest_own_goal"])
+})
+
+// QuickestRedCard returns the teams who have received at least one red card in ascending order of match minute
+var QuickestRedCard = NewRankedPrizeGenerator(quickestRedCard, "quickest_red_card", RankAscending, func(s *Sweepstake) []RankValue {
 	events := make([]matchEventWithTeams, 0)
 
-	for _, match := range s.Tournament.Matches {
+	for _, match := range s.Tournament.Matches.FilterForPrizes() {
 		if !match.Completed {
 			continue
 		}
@@ -233,35 +919,450 @@ var QuickestRedCard = func(s *Sweepstake) *RankedPrize {
 		events = append(events, (&matchEventsExtractor{match: match}).redCards()...)
 	}
 
-	return &RankedPrize{
-		PrizeName: quickestRedCard,
-		Rankings:  getPrizeRankingsFromMatchEvents("🟥", events, s.Participants),
+	return getRankValuesFromMatchEvents(events, s.Tournament, s.MinuteBounds["quickest_red_card"])
+})
+
+// Entertainers returns the teams who have played in the most high-scoring matches in descending order
+//
+// A match is high-scoring if its total goals are at least the tournament's high-scoring goals (default 4)
+var Entertainers = NewRankedPrizeGenerator(entertainers, "entertainers", RankDescending, func(s *Sweepstake) []RankValue {
+	threshold := s.Tournament.highScoringGoals()
+
+	totals := s.Tournament.cachedAudit("entertainers", func() teamsAudit {
+		totals := teamsAudit{teams: s.Tournament.Teams}
+
+		for _, match := range s.Tournament.Matches.FilterForPrizes() {
+			if !match.Completed || int(match.Home.Goals)+int(match.Away.Goals) < threshold {
+				continue
+			}
+
+			totals.inc(match.Home.Team, 1)
+			totals.inc(match.Away.Team, 1)
+		}
+
+		return totals
+	})
+
+	values := getRankValuesFromAudit(totals)
+	for idx := range values {
+		values[idx].Threshold = threshold
+	}
+
+	return values
+})
+
+// MinuteBounds restricts the match minutes of the events that count towards a "quickest" prize
+type MinuteBounds struct {
+	Min uint8 `json:"min"` // earliest minute that counts, or 0 for no lower bound
+	Max uint8 `json:"max"` // latest minute that counts (excluding any stopped time that extends it, e.g. 90+3), or 0 for no upper bound
+}
+
+// includes returns true if the provided event took place within the bounds
+func (b MinuteBounds) includes(ev MatchEvent) bool {
+	if ev.Minute < b.Min {
+		return false
 	}
+
+	if b.Max > 0 && (ev.Minute > b.Max || (ev.Minute == b.Max && ev.Offset > 0)) {
+		return false
+	}
+
+	return true
 }
 
-func getPrizeRankingsFromMatchEvents(prefix string, events []matchEventWithTeams, participants ParticipantCollection) []Rank {
+// quickestPrizeKeys defines the key of each prize that ranks teams by the match minute of an event, which can be restricted by MinuteBounds
+var quickestPrizeKeys = []string{"quickest_hat_trick", "quickest_own_goal", "quickest_red_card"}
+
+// validateMinuteBounds ensures that each of the provided bounds is keyed by a "quickest" prize, and that its min does not exceed its max
+func validateMinuteBounds(bounds map[string]MinuteBounds, mErr MultiError) {
+	keys := make([]string, 0, len(bounds))
+	for key := range bounds {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		var found bool
+		for _, quickestKey := range quickestPrizeKeys {
+			if key == quickestKey {
+				found = true
+				break
+			}
+		}
+
+		b := bounds[key]
+		switch {
+		case !found:
+			mErr.Add(fmt.Errorf("minute bounds key '%s': %w", key, ErrNotFound))
+		case b.Max > 0 && b.Min > b.Max:
+			mErr.Add(fmt.Errorf("minute bounds '%s': min %d must not exceed max %d", key, b.Min, b.Max))
+		}
+	}
+}
+
+// validateMinimumValues ensures that each of the provided minimum values is keyed by a ranked prize that is ranked in descending order,
+// and is positive
+func validateMinimumValues(minimums map[string]int, mErr MultiError) {
+	keys := make([]string, 0, len(minimums))
+	for key := range minimums {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		var quickest bool
+		for _, quickestKey := range quickestPrizeKeys {
+			if key == quickestKey {
+				quickest = true
+				break
+			}
+		}
+
+		switch {
+		case rankedPrizeGenerator(key) == nil || quickest:
+			mErr.Add(fmt.Errorf("minimum values key '%s': %w", key, ErrNotFound))
+		case minimums[key] < 1:
+			mErr.Add(fmt.Errorf("minimum values '%s': %d must be positive", key, minimums[key]))
+		}
+	}
+}
+
+// getRankValuesFromMatchEvents returns the match minute of each of the provided events that is within the provided bounds, in ascending order
+//
+// Events at an identical minute are ordered by offset (asc), then by match timestamp (asc), then by team name (asc)
+func getRankValuesFromMatchEvents(events []matchEventWithTeams, tournament *Tournament, bounds MinuteBounds) []RankValue {
+	filtered := make([]matchEventWithTeams, 0, len(events))
+	for _, ev := range events {
+		if bounds.includes(ev.MatchEvent) {
+			filtered = append(filtered, ev)
+		}
+	}
+	events = filtered
+
 	sort.SliceStable(events, func(i, j int) bool {
-		// sort by minute (asc) then by offset (asc)
 		switch {
-		case events[i].Minute == events[j].Minute:
+		case events[i].Minute != events[j].Minute:
+			return events[i].Minute < events[j].Minute
+		case events[i].Offset != events[j].Offset:
 			return events[i].Offset < events[j].Offset
+		case !events[i].Timestamp.Equal(events[j].Timestamp):
+			return events[i].Timestamp.Before(events[j].Timestamp)
 		default:
-			return events[i].Minute < events[j].Minute
+			return events[i].For.Name < events[j].For.Name
 		}
 	})
 
+	values := make([]RankValue, 0)
+
+	for _, ev := range events {
+		event := ev.MatchEvent
+
+		values = append(values, RankValue{
+			Team:    ev.For,
+			Value:   int(ev.Minute),
+			Event:   &event,
+			Against: ev.Against,
+			Date:    tournament.inLocation(ev.Timestamp).Format("02/01"),
+			at:      ev.Timestamp,
+		})
+	}
+
+	return values
+}
+
+// OverallStandings returns the participants who have earned the most points across the sweepstake's weighted prizes in descending order
+//
+// Each weighted prize awards points to the participant at each of its positions in turn (e.g. a weighting of [3, 2, 1] awards 3 points to 1st,
+// 2 points to 2nd and 1 point to 3rd), and the winner of an outright prize is considered to be in 1st position once it is resolved.
+// Participants with an identical total are ordered alphabetically, and participants without any points are omitted
+var OverallStandings = func(s *Sweepstake) *RankedPrize {
+	defaultPrize := &RankedPrize{
+		PrizeName: overallStandings,
+		Rankings:  make([]Rank, 0),
+	}
+
+	if s == nil {
+		return defaultPrize
+	}
+
+	type standing struct {
+		participantName string
+		imageURL        string
+		points          int
+		team            *Team
+		at              time.Time
+	}
+
+	standings := make([]*standing, 0)
+	byName := make(map[string]*standing)
+
+	award := func(participantName, imageURL string, points int, team *Team, at time.Time) {
+		if _, ok := byName[participantName]; !ok {
+			byName[participantName] = &standing{participantName: participantName, imageURL: imageURL, team: team, at: at}
+			standings = append(standings, byName[participantName])
+		}
+		byName[participantName].points += points
+	}
+
+	// weigh prizes in default order, so that the standings are deterministic
+	for _, key := range prizeKeys {
+		weighting := s.PrizeWeightings[key]
+		if len(weighting) == 0 {
+			continue
+		}
+
+		if generator := outrightPrizeGenerator(key); generator != nil {
+			if prize := generator(s); prize.IsResolved() {
+				award(prize.ParticipantName, prize.ImageURL, weighting[0], prize.team, prize.at)
+			}
+			continue
+		}
+
+		if generator := rankedPrizeGenerator(key); generator != nil {
+			for _, rank := range generator(s).Rankings {
+				if idx := int(rank.Position) - 1; idx < len(weighting) {
+					award(rank.ParticipantName, rank.ImageURL, weighting[idx], rank.team, rank.at)
+				}
+			}
+		}
+	}
+
+	sort.SliceStable(standings, func(i, j int) bool {
+		if standings[i].points != standings[j].points {
+			return standings[i].points > standings[j].points
+		}
+		return standings[i].participantName < standings[j].participantName
+	})
+
 	rankings := make([]Rank, 0)
 
-	for idx, ev := range events {
+	for _, st := range standings {
+		if st.points <= 0 {
+			continue
+		}
+
 		rankings = append(rankings, Rank{
-			Position:        uint8(idx + 1),
-			ImageURL:        ev.For.ImageURL,
-			ParticipantName: getSummaryFromTeamAndParticipant(ev.For, participants.GetByTeamID(ev.For.ID)),
-			Value:           fmt.Sprintf("%s %s (vs %s %s)", prefix, ev.String(), ev.Against.Name, ev.Timestamp.Format("02/01")),
+			Position:        uint8(len(rankings) + 1),
+			ImageURL:        st.imageURL,
+			ParticipantName: st.participantName,
+			Value:           s.formatRankValue("overall_standings", RankValue{Value: st.points}),
+			team:            st.team,
+			at:              st.at,
 		})
 	}
 
-	return rankings
+	return &RankedPrize{
+		PrizeName: overallStandings,
+		Rankings:  rankings,
+	}
+}
+
+// outrightPrizeGenerator returns the generator of the outright prize with the provided key, or nil if the key does not represent an outright prize
+func outrightPrizeGenerator(key string) OutrightPrizeGenerator {
+	switch key {
+	case "winner":
+		return TournamentWinner
+	case "runner_up":
+		return TournamentRunnerUp
+	case "wooden_spoon":
+		return WoodenSpoon
+	case "first_eliminated":
+		return FirstEliminated
+	default:
+		return nil
+	}
+}
+
+// rankedPrizeGenerator returns the generator of the ranked prize with the provided key, or nil if the key does not represent a ranked prize
+//
+// The overall standings are excluded, since they are composed of the other prizes
+func rankedPrizeGenerator(key string) RankedPrizeGenerator {
+	switch key {
+	case "most_goals_conceded":
+		return MostGoalsConceded
+	case "most_goals_knockouts":
+		return MostGoalsInKnockouts
+	case "goal_rush":
+		return GoalRush
+	case "longest_winning_streak":
+		return LongestWinningStreak
+	case "longest_defensive_run":
+		return LongestDefensiveRun
+	case "most_comeback_wins":
+		return MostComebackWins
+	case "quickest_hat_trick":
+		return QuickestHatTrick
+	case "most_different_scorers":
+		return MostDifferentScorers
+	case "most_assists":
+		return MostAssists
+	case "most_yellow_cards":
+		return MostYellowCards
+	case "quickest_own_goal":
+		return QuickestOwnGoal
+	case "quickest_red_card":
+		return QuickestRedCard
+	case "entertainers":
+		return Entertainers
+	case "most_conceded_single_match":
+		return MostConcededInMatch
+	default:
+		return nil
+	}
+}
+
+// validatePrizeWeightings ensures that each of the provided weightings is keyed by a prize that can be weighted, and awards at least one position
+func validatePrizeWeightings(weightings map[string][]int, mErr MultiError) {
+	keys := make([]string, 0, len(weightings))
+	for key := range weightings {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		switch {
+		case outrightPrizeGenerator(key) == nil && rankedPrizeGenerator(key) == nil:
+			mErr.Add(fmt.Errorf("prize weighting key '%s': %w", key, ErrNotFound))
+		case len(weightings[key]) == 0:
+			mErr.Add(fmt.Errorf("prize weighting '%s': %w", key, ErrIsEmpty))
+		}
+	}
+}
+
+// defaultValueTemplates defines the template used to render the value of each ranked prize, unless the tournament specifies otherwise
+var defaultValueTemplates = mustParseValueTemplates(map[string]string{
+	"most_goals_conceded":        "⚽️ {{ .Value }}",
+	"most_goals_knockouts":       "⚽️ {{ .Value }}",
+	"goal_rush":                  "⚡ {{ .Value }} in {{ .Half }} (vs {{ with .Against }}{{ .Name }}{{ end }} {{ .Date }})",
+	"longest_winning_streak":     "🔥 {{ .Value }} {{ if eq .Value 1 }}win{{ else }}wins{{ end }}",
+	"longest_defensive_run":      "🛡 {{ .Value }} {{ if eq .Value 1 }}game{{ else }}games{{ end }}",
+	"most_comeback_wins":         "🔄 {{ .Value }} {{ if eq .Value 1 }}comeback{{ else }}comebacks{{ end }}",
+	"quickest_hat_trick":         "🎩 {{ .Event }} (vs {{ with .Against }}{{ .Name }}{{ end }} {{ .Date }})",
+	"most_different_scorers":     "👥 {{ .Value }} {{ if eq .Value 1 }}scorer{{ else }}scorers{{ end }}",
+	"most_assists":               "🅰️ {{ .Value }} {{ if eq .Value 1 }}assist{{ else }}assists{{ end }}",
+	"most_yellow_cards":          "🟨️ {{ .Value }}",
+	"quickest_own_goal":          "🙈 {{ .Event }} (vs {{ with .Against }}{{ .Name }}{{ end }} {{ .Date }})",
+	"quickest_red_card":          "🟥 {{ .Event }} (vs {{ with .Against }}{{ .Name }}{{ end }} {{ .Date }})",
+	"entertainers":               "🍿 {{ .Value }} ({{ .Threshold }}+ goal games)",
+	"most_conceded_single_match": "😬 {{ .Value }} (vs {{ with .Against }}{{ .Name }}{{ end }} {{ .Date }})",
+	"overall_standings":          "🏅 {{ .Value }} {{ if eq .Value 1 }}pt{{ else }}pts{{ end }}",
+})
+
+// RankValue provides the context of a ranked prize value to its template
+type RankValue struct {
+	Team      *Team       // team that is ranked
+	Value     int         // quantity that the team is ranked by (e.g. goals), or the match minute of the ranked event
+	Half      string      // half of the match in which the goals were scored (e.g. "H1" or "ET2"), if any
+	Event     *MatchEvent // match event that the team is ranked by (e.g. an own goal), if any
+	Against   *Team       // opponent of the team within the match, if any
+	Date      string      // date of the match in the tournament's location (e.g. "26/05"), if any
+	Threshold int         // minimum quantity that qualifies towards the value (e.g. total goals of a high-scoring match), if any
+
+	at time.Time // kick-off of the match that the value is attributed to, if any, which determines the participant who is assigned the team
+}
+
+// formatRankValue returns the provided value rendered by the tournament's value template for the provided prize key
+//
+// If the tournament has no such template, the default value template is used instead. If the tournament's template cannot be rendered,
+// the default value template is also used and the problem is reported as a warning of the sweepstake (see Sweepstake.RenderWarnings)
+func (s *Sweepstake) formatRankValue(key string, value RankValue) string {
+	if tpl, err := s.Tournament.valueTemplate(key); err != nil {
+		s.warnings.add(err)
+	} else if tpl != nil {
+		rendered, err := renderValueTemplate(tpl, value)
+		if err == nil {
+			return rendered
+		}
+		s.warnings.add(fmt.Errorf("value template '%s': cannot execute: %w", key, err))
+	}
+
+	rendered, err := renderValueTemplate(defaultValueTemplates[key], value)
+	if err != nil {
+		s.warnings.add(fmt.Errorf("default value template '%s': cannot execute: %w", key, err))
+	}
+
+	return rendered
+}
+
+// parsedValueTemplate represents a value template of a tournament once it has been parsed
+type parsedValueTemplate struct {
+	tpl *template.Template
+	err error
+}
+
+// valueTemplate returns the tournament's parsed value template for the provided prize key, or nil if the tournament does not specify one
+//
+// The tournament's value templates are only parsed once, which is usually when the tournament is loaded (see validateValueTemplates)
+func (t *Tournament) valueTemplate(key string) (*template.Template, error) {
+	if t == nil {
+		return nil, nil
+	}
+
+	parsed, ok := t.parseValueTemplates()[key]
+	if !ok {
+		return nil, nil
+	}
+
+	if parsed.err != nil {
+		return nil, fmt.Errorf("value template '%s': cannot parse: %w", key, parsed.err)
+	}
+
+	return parsed.tpl, nil
+}
+
+// parseValueTemplates returns each of the tournament's value templates, keyed by prize key, parsing them if this has not already happened
+func (t *Tournament) parseValueTemplates() map[string]parsedValueTemplate {
+	t.valueTemplatesOnce.Do(func() {
+		t.valueTemplates = make(map[string]parsedValueTemplate, len(t.ValueTemplates))
+		for key, src := range t.ValueTemplates {
+			tpl, err := template.New(key).Parse(src)
+			t.valueTemplates[key] = parsedValueTemplate{tpl: tpl, err: err}
+		}
+	})
+
+	return t.valueTemplates
+}
+
+// validateValueTemplates ensures that each of the provided tournament's value templates is keyed by a ranked prize and can be parsed
+func validateValueTemplates(tournament *Tournament, mErr MultiError) {
+	parsed := tournament.parseValueTemplates()
+
+	keys := make([]string, 0, len(parsed))
+	for key := range parsed {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if _, ok := defaultValueTemplates[key]; !ok {
+			mErr.Add(fmt.Errorf("value template key '%s': %w", key, ErrNotFound))
+			continue
+		}
+
+		if err := parsed[key].err; err != nil {
+			mErr.Add(fmt.Errorf("value template '%s': cannot parse: %w", key, err))
+		}
+	}
+}
+
+// mustParseValueTemplates returns each of the provided value template sources parsed as a template, keyed by prize key
+func mustParseValueTemplates(sources map[string]string) map[string]*template.Template {
+	templates := make(map[string]*template.Template, len(sources))
+	for key, src := range sources {
+		templates[key] = template.Must(template.New(key).Parse(src))
+	}
+
+	return templates
+}
+
+// renderValueTemplate returns the provided value rendered by the provided template
+func renderValueTemplate(tpl *template.Template, value RankValue) (string, error) {
+	buf := &bytes.Buffer{}
+	if err := tpl.Execute(buf, value); err != nil {
+		return "", err
+	}
+
+	return buf.String(), nil
 }
 
 type matchEventWithTeams struct {
@@ -276,67 +1377,61 @@ type matchEventsExtractor struct {
 }
 
 func (m *matchEventsExtractor) ownGoals() []matchEventWithTeams {
-	events := make([]matchEventWithTeams, 0)
-	timestamp := m.match.Timestamp
-	home := m.match.Home
-	away := m.match.Away
-
-	for _, og := range home.OwnGoals {
-		events = append(events, matchEventWithTeams{
-			MatchEvent: og,
-			Timestamp:  timestamp,
-			For:        home.Team,
-			Against:    away.Team,
-		})
-	}
-
-	for _, og := range away.OwnGoals {
-		events = append(events, matchEventWithTeams{
-			MatchEvent: og,
-			Timestamp:  timestamp,
-			For:        away.Team,
-			Against:    home.Team,
-		})
-	}
+	return m.extract(func(competitor MatchCompetitor) []MatchEvent {
+		return competitor.OwnGoals
+	})
+}
 
-	return events
+func (m *matchEventsExtractor) scorers() []matchEventWithTeams {
+	return m.extract(func(competitor MatchCompetitor) []MatchEvent {
+		return competitor.Scorers
+	})
 }
 
 func (m *matchEventsExtractor) redCards() []matchEventWithTeams {
+	return m.extract(func(competitor MatchCompetitor) []MatchEvent {
+		return competitor.RedCards
+	})
+}
+
+// extract returns the events provided by eventsFn for each of the match's competitors
+func (m *matchEventsExtractor) extract(eventsFn func(competitor MatchCompetitor) []MatchEvent) []matchEventWithTeams {
 	events := make([]matchEventWithTeams, 0)
-	timestamp := m.match.Timestamp
-	home := m.match.Home
-	away := m.match.Away
-
-	for _, rc := range home.RedCards {
-		events = append(events, matchEventWithTeams{
-			MatchEvent: rc,
-			Timestamp:  timestamp,
-			For:        home.Team,
-			Against:    away.Team,
-		})
-	}
 
-	for _, rc := range away.RedCards {
-		events = append(events, matchEventWithTeams{
-			MatchEvent: rc,
-			Timestamp:  timestamp,
-			For:        away.Team,
-			Against:    home.Team,
-		})
+	for _, competitor := range m.match.Competitors() {
+		if competitor.Team == nil {
+			continue // events cannot be attributed to a team that is not yet known
+		}
+
+		var against *Team
+		if opponent := m.match.OpponentOf(competitor.Team); opponent != nil {
+			against = opponent.Team
+		}
+
+		for _, ev := range eventsFn(competitor) {
+			events = append(events, matchEventWithTeams{
+				MatchEvent: ev,
+				Timestamp:  m.match.Timestamp,
+				For:        competitor.Team,
+				Against:    against,
+			})
+		}
 	}
 
 	return events
 }
 
 type RankedPrize struct {
-	PrizeName string
-	Rankings  []Rank
+	PrizeName string `json:"prize_name"`
+	Rankings  []Rank `json:"rankings"`
 }
 
 type Rank struct {
-	Position        uint8  // numerical position of rank
-	ImageURL        string // image url
-	ParticipantName string // participant name
-	Value           string // match minute or qty (e.g. "45'+2" or "2 goals")
+	Position        uint8  `json:"position"`         // numerical position of rank
+	ImageURL        string `json:"image_url"`        // image url
+	ParticipantName string `json:"participant_name"` // participant name
+	Value           string `json:"value"`            // match minute or qty (e.g. "45'+2" or "2 goals")
+
+	team *Team     // team that is ranked
+	at   time.Time // time at which the team earned the rank, which determines the participant who is assigned the team (zero if current)
 }
@@ -1,6 +1,9 @@
 package domain_test
 
 import (
+	"fmt"
+	"reflect"
+	"sync"
 	"testing"
 	"time"
 
@@ -8,12 +11,25 @@ import (
 )
 
 const (
-	mostGoalsConceded  = "Most Goals Conceded"
-	mostYellowCards    = "Most Yellow Cards"
-	quickestOwnGoal    = "Quickest Own Goal"
-	quickestRedCard    = "Quickest Red Card"
-	tournamentRunnerUp = "Tournament Runner-Up"
-	tournamentWinner   = "Tournament Winner"
+	entertainers         = "Entertainers"
+	firstEliminated      = "First Eliminated"
+	goalRush             = "Goal Rush"
+	longestDefensiveRun  = "Longest Defensive Run"
+	longestWinningStreak = "Longest Winning Streak"
+	mostAssists          = "Most Assists"
+	mostComebackWins     = "Most Comeback Wins"
+	mostConcededInMatch  = "Most Conceded In A Match"
+	mostDifferentScorers = "Most Different Scorers"
+	mostGoalsConceded    = "Most Goals Conceded"
+	mostGoalsInKnockouts = "Most Goals In Knockouts"
+	mostYellowCards      = "Most Yellow Cards"
+	overallStandings     = "Overall Standings"
+	quickestHatTrick     = "Quickest Hat-Trick"
+	quickestOwnGoal      = "Quickest Own Goal"
+	quickestRedCard      = "Quickest Red Card"
+	tournamentRunnerUp   = "Tournament Runner-Up"
+	tournamentWinner     = "Tournament Winner"
+	woodenSpoon          = "Wooden Spoon"
 )
 
 var (
@@ -31,6 +47,40 @@ var (
 	tz           = time.FixedZone("Europe/London", 3600)
 )
 
+func TestOutrightPrize_IsResolved(t *testing.T) {
+	tt := []struct {
+		name         string
+		prize        *domain.OutrightPrize
+		wantResolved bool
+	}{
+		{
+			name: "prize with participant name must be resolved",
+			prize: &domain.OutrightPrize{
+				PrizeName:       tournamentWinner,
+				ParticipantName: "Marc Pugh (Team A)",
+				ImageURL:        "http://teamA.jpg",
+			},
+			wantResolved: true,
+		},
+		{
+			name:         "default prize must not be resolved",
+			prize:        domain.TournamentWinner(nil),
+			wantResolved: false,
+		},
+		{
+			name:         "no prize must not be resolved",
+			wantResolved: false,
+			// nil prize
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			cmpDiff(t, tc.wantResolved, tc.prize.IsResolved())
+		})
+	}
+}
+
 func TestTournamentWinner(t *testing.T) {
 	defaultPrize := &domain.OutrightPrize{PrizeName: tournamentWinner, ParticipantName: "TBC"}
 
@@ -59,6 +109,27 @@ func TestTournamentWinner(t *testing.T) {
 				ImageURL:        "http://teamA.jpg",
 			},
 		},
+		{
+			name: "completed final match with winning team and custom summary format must return prize with formatted summary",
+			sweepstake: &domain.Sweepstake{
+				Tournament: &domain.Tournament{
+					Matches: domain.MatchCollection{
+						{
+							ID:        "F",
+							Completed: true,
+							Winner:    teamA,
+						},
+					},
+					SummaryFormat: "%[2]s — %[1]s",
+				},
+				Participants: domain.ParticipantCollection{participantA},
+			},
+			wantPrize: &domain.OutrightPrize{
+				PrizeName:       tournamentWinner,
+				ParticipantName: "Team A — Marc Pugh",
+				ImageURL:        "http://teamA.jpg",
+			},
+		},
 		{
 			name: "completed final match with winning team and no participant name must return prize with team name only",
 			sweepstake: &domain.Sweepstake{
@@ -104,6 +175,74 @@ func TestTournamentWinner(t *testing.T) {
 				ImageURL:        "http://teamA.jpg",
 			},
 		},
+		{
+			name: "completed final match with winning team and participant name must ignore unclaimed label",
+			sweepstake: &domain.Sweepstake{
+				Tournament: &domain.Tournament{
+					Matches: domain.MatchCollection{
+						{
+							ID:        "F",
+							Completed: true,
+							Winner:    teamA,
+						},
+					},
+					UnclaimedLabel: "Unclaimed",
+				},
+				Participants: domain.ParticipantCollection{participantA},
+			},
+			wantPrize: &domain.OutrightPrize{
+				PrizeName:       tournamentWinner,
+				ParticipantName: "Marc Pugh (Team A)",
+				ImageURL:        "http://teamA.jpg",
+			},
+		},
+		{
+			name: "completed final match with winning team and no participant name must ignore unclaimed label",
+			sweepstake: &domain.Sweepstake{
+				Tournament: &domain.Tournament{
+					Matches: domain.MatchCollection{
+						{
+							ID:        "F",
+							Completed: true,
+							Winner:    teamA,
+						},
+					},
+					UnclaimedLabel: "Unclaimed",
+				},
+				Participants: domain.ParticipantCollection{
+					{
+						TeamID: "teamA",
+						// no name
+					},
+				},
+			},
+			wantPrize: &domain.OutrightPrize{
+				PrizeName:       tournamentWinner,
+				ParticipantName: "Team A",
+				ImageURL:        "http://teamA.jpg",
+			},
+		},
+		{
+			name: "completed final match with winning team and no participant must return prize with unclaimed label and team name",
+			sweepstake: &domain.Sweepstake{
+				Tournament: &domain.Tournament{
+					Matches: domain.MatchCollection{
+						{
+							ID:        "F",
+							Completed: true,
+							Winner:    teamA,
+						},
+					},
+					UnclaimedLabel: "Unclaimed",
+				},
+				// no participants
+			},
+			wantPrize: &domain.OutrightPrize{
+				PrizeName:       tournamentWinner,
+				ParticipantName: "Unclaimed (Team A)",
+				ImageURL:        "http://teamA.jpg",
+			},
+		},
 		{
 			name: "final match that has not yet completed must return default prize",
 			sweepstake: &domain.Sweepstake{
@@ -136,6 +275,23 @@ func TestTournamentWinner(t *testing.T) {
 			},
 			wantPrize: defaultPrize,
 		},
+		{
+			name: "final excluded from prizes must return default prize",
+			sweepstake: &domain.Sweepstake{
+				Tournament: &domain.Tournament{
+					Matches: domain.MatchCollection{
+						{
+							ID:                "F",
+							Completed:         true,
+							Winner:            teamA,
+							ExcludeFromPrizes: true,
+						},
+					},
+				},
+				Participants: domain.ParticipantCollection{participantA},
+			},
+			wantPrize: defaultPrize,
+		},
 		{
 			name: "no final must return default prize",
 			sweepstake: &domain.Sweepstake{
@@ -152,6 +308,43 @@ func TestTournamentWinner(t *testing.T) {
 			},
 			wantPrize: defaultPrize,
 		},
+		{
+			name: "completed match with custom final match id must return prize with participant name and team name",
+			sweepstake: &domain.Sweepstake{
+				Tournament: &domain.Tournament{
+					Matches: domain.MatchCollection{
+						{
+							ID:        "F",
+							Completed: true,
+							Winner:    teamB,
+						},
+						{
+							ID:        "M64",
+							Completed: true,
+							Winner:    teamA,
+						},
+					},
+					FinalMatchID: "M64",
+				},
+				Participants: domain.ParticipantCollection{participantA, participantB},
+			},
+			wantPrize: &domain.OutrightPrize{
+				PrizeName:       tournamentWinner,
+				ParticipantName: "Marc Pugh (Team A)",
+				ImageURL:        "http://teamA.jpg",
+			},
+		},
+		{
+			name: "no matches must return default prize",
+			sweepstake: &domain.Sweepstake{
+				Tournament: &domain.Tournament{
+					Teams: domain.TeamCollection{teamA, teamB},
+					// no matches
+				},
+				Participants: domain.ParticipantCollection{participantA, participantB},
+			},
+			wantPrize: defaultPrize,
+		},
 		{
 			name:      "no sweepstake must return default prize",
 			wantPrize: defaultPrize,
@@ -416,6 +609,17 @@ func TestTournamentRunnerUp(t *testing.T) {
 			},
 			wantPrize: defaultPrize,
 		},
+		{
+			name: "no matches must return default prize",
+			sweepstake: &domain.Sweepstake{
+				Tournament: &domain.Tournament{
+					Teams: domain.TeamCollection{teamA, teamB},
+					// no matches
+				},
+				Participants: domain.ParticipantCollection{participantA, participantB},
+			},
+			wantPrize: defaultPrize,
+		},
 		{
 			name:      "no sweepstake must return default prize",
 			wantPrize: defaultPrize,
@@ -431,379 +635,243 @@ func TestTournamentRunnerUp(t *testing.T) {
 	}
 }
 
-func TestMostGoalsConceded(t *testing.T) {
-	defaultPrize := &domain.RankedPrize{PrizeName: mostGoalsConceded, Rankings: []domain.Rank{}}
-
-	teams := domain.TeamCollection{teamA, teamB, teamC, teamD}
+func TestWoodenSpoon(t *testing.T) {
+	defaultPrize := &domain.OutrightPrize{PrizeName: woodenSpoon, ParticipantName: "TBC"}
 	participants := domain.ParticipantCollection{participantA, participantB, participantC, participantD}
+	teams := domain.TeamCollection{teamA, teamB, teamC, teamD}
 
 	tt := []struct {
 		name       string
 		sweepstake *domain.Sweepstake
-		wantPrize  *domain.RankedPrize
+		wantPrize  *domain.OutrightPrize
 	}{
 		{
-			name: "valid sweepstake must produce the expected rankings",
+			name: "team with fewest points must return prize with participant name and team name",
 			sweepstake: &domain.Sweepstake{
 				Tournament: &domain.Tournament{
 					Teams: teams,
 					Matches: domain.MatchCollection{
-						// teamA = 1 (1)
-						// teamB = 2 (2)
 						{
+							ID:        "A1",
 							Completed: true,
+							Winner:    teamA,
 							Home: domain.MatchCompetitor{
 								Team:  teamA,
 								Goals: 2,
 							},
 							Away: domain.MatchCompetitor{
 								Team:  teamB,
-								Goals: 1,
+								Goals: 0,
 							},
 						},
-						// not completed, should be ignored
 						{
-							// completed is false
+							ID:        "A2",
+							Completed: true,
 							Home: domain.MatchCompetitor{
-								Team:  teamA,
-								Goals: 99,
+								Team:  teamC,
+								Goals: 1,
 							},
 							Away: domain.MatchCompetitor{
-								Team:  teamB,
-								Goals: 99,
+								Team:  teamD,
+								Goals: 1,
 							},
 						},
-						// teamB = 3 (5)
-						// teamC = 2 (2)
 						{
+							ID:        "A3",
 							Completed: true,
+							Winner:    teamA,
 							Home: domain.MatchCompetitor{
-								Team:  teamB,
-								Goals: 2,
+								Team:  teamA,
+								Goals: 3,
 							},
 							Away: domain.MatchCompetitor{
-								Team:  teamC,
-								Goals: 3,
+								Team:  teamD,
+								Goals: 1,
 							},
 						},
-						// teamB = 1 (6)
-						// teamD = 0 (0)
 						{
+							ID:        "A4",
 							Completed: true,
+							Winner:    teamC,
 							Home: domain.MatchCompetitor{
-								Team:  teamB,
-								Goals: 0,
+								Team:  teamC,
+								Goals: 2,
 							},
 							Away: domain.MatchCompetitor{
-								Team:  teamD,
-								Goals: 1,
+								Team:  teamB,
+								Goals: 0,
 							},
 						},
 					},
 				},
 				Participants: participants,
 			},
-			wantPrize: &domain.RankedPrize{
-				PrizeName: mostGoalsConceded,
-				Rankings: []domain.Rank{
-					{
-						Position:        1,
-						ImageURL:        "http://teamB.jpg",
-						ParticipantName: "Steve Fletcher (Team B)",
-						Value:           "⚽️ 6",
-					},
-					{
-						Position:        2,
-						ImageURL:        "http://teamC.jpg",
-						ParticipantName: "Brett Pitman (Team C)",
-						Value:           "⚽️ 2",
-					},
-					{
-						Position:        3,
-						ImageURL:        "http://teamA.jpg",
-						ParticipantName: "Marc Pugh (Team A)",
-						Value:           "⚽️ 1",
-					},
-					// teamD do not rank
-				},
+			wantPrize: &domain.OutrightPrize{
+				PrizeName:       woodenSpoon,
+				ParticipantName: "Steve Fletcher (Team B)",
+				ImageURL:        "http://teamB.jpg",
 			},
 		},
 		{
-			name:      "no sweepstake must return default prize",
-			wantPrize: defaultPrize,
-			// nil sweepstake
-		},
-	}
-
-	for _, tc := range tt {
-		t.Run(tc.name, func(t *testing.T) {
-			gotPrize := domain.MostGoalsConceded(tc.sweepstake)
-			cmpDiff(t, tc.wantPrize, gotPrize)
-		})
-	}
-}
-
-func TestMostYellowCards(t *testing.T) {
-	defaultPrize := &domain.RankedPrize{PrizeName: mostYellowCards, Rankings: []domain.Rank{}}
-
-	teams := domain.TeamCollection{teamA, teamB, teamC, teamD}
-	participants := domain.ParticipantCollection{participantA, participantB, participantC, participantD}
-
-	tt := []struct {
-		name       string
-		sweepstake *domain.Sweepstake
-		wantPrize  *domain.RankedPrize
-	}{
-		{
-			name: "valid sweepstake must produce the expected rankings",
+			name: "teams tied on fewest points must return prize for team with worst goal difference",
 			sweepstake: &domain.Sweepstake{
 				Tournament: &domain.Tournament{
 					Teams: teams,
 					Matches: domain.MatchCollection{
-						// teamA = 1 (1)
-						// teamB = 2 (2)
 						{
+							ID:        "A1",
 							Completed: true,
+							Winner:    teamA,
 							Home: domain.MatchCompetitor{
-								Team:        teamA,
-								YellowCards: 1,
+								Team:  teamA,
+								Goals: 1,
 							},
 							Away: domain.MatchCompetitor{
-								Team:        teamB,
-								YellowCards: 2,
+								Team:  teamB,
+								Goals: 0,
 							},
 						},
-						// not completed, should be ignored
 						{
-							// completed is false
+							ID:        "A2",
+							Completed: true,
+							Winner:    teamC,
 							Home: domain.MatchCompetitor{
-								Team:        teamA,
-								YellowCards: 99,
+								Team:  teamC,
+								Goals: 3,
 							},
 							Away: domain.MatchCompetitor{
-								Team:        teamB,
-								YellowCards: 99,
+								Team:  teamD,
+								Goals: 0,
 							},
 						},
-						// teamB = 3 (5)
-						// teamC = 2 (2)
+					},
+				},
+				Participants: participants,
+			},
+			wantPrize: &domain.OutrightPrize{
+				PrizeName:       woodenSpoon,
+				ParticipantName: "Shaun McDonald (Team D)",
+				ImageURL:        "http://teamD.jpg",
+			},
+		},
+		{
+			name: "teams tied on fewest points and goal difference must return prize for team that has conceded most goals",
+			sweepstake: &domain.Sweepstake{
+				Tournament: &domain.Tournament{
+					Teams: teams,
+					Matches: domain.MatchCollection{
 						{
+							ID:        "A1",
 							Completed: true,
+							Winner:    teamA,
 							Home: domain.MatchCompetitor{
-								Team:        teamB,
-								YellowCards: 3,
+								Team:  teamA,
+								Goals: 1,
 							},
 							Away: domain.MatchCompetitor{
-								Team:        teamC,
-								YellowCards: 2,
+								Team:  teamB,
+								Goals: 0,
 							},
 						},
-						// teamB = 1 (6)
-						// teamD = 0 (0)
 						{
+							ID:        "A2",
 							Completed: true,
+							Winner:    teamC,
 							Home: domain.MatchCompetitor{
-								Team:        teamB,
-								YellowCards: 1,
+								Team:  teamC,
+								Goals: 3,
 							},
 							Away: domain.MatchCompetitor{
-								Team:        teamD,
-								YellowCards: 0,
+								Team:  teamD,
+								Goals: 2,
 							},
 						},
 					},
 				},
 				Participants: participants,
 			},
-			wantPrize: &domain.RankedPrize{
-				PrizeName: mostYellowCards,
-				Rankings: []domain.Rank{
-					{
-						Position:        1,
-						ImageURL:        "http://teamB.jpg",
-						ParticipantName: "Steve Fletcher (Team B)",
-						Value:           "\U0001F7E8️ 6",
-					},
-					{
-						Position:        2,
-						ImageURL:        "http://teamC.jpg",
-						ParticipantName: "Brett Pitman (Team C)",
-						Value:           "\U0001F7E8️ 2",
-					},
-					{
-						Position:        3,
-						ImageURL:        "http://teamA.jpg",
-						ParticipantName: "Marc Pugh (Team A)",
-						Value:           "\U0001F7E8️ 1",
-					},
-					// teamD do not rank
-				},
+			wantPrize: &domain.OutrightPrize{
+				PrizeName:       woodenSpoon,
+				ParticipantName: "Shaun McDonald (Team D)",
+				ImageURL:        "http://teamD.jpg",
 			},
 		},
 		{
-			name:      "no sweepstake must return default prize",
-			wantPrize: defaultPrize,
-			// nil sweepstake
-		},
-	}
-
-	for _, tc := range tt {
-		t.Run(tc.name, func(t *testing.T) {
-			gotPrize := domain.MostYellowCards(tc.sweepstake)
-			cmpDiff(t, tc.wantPrize, gotPrize)
-		})
-	}
-}
-
-func TestQuickestOwnGoal(t *testing.T) {
-	defaultPrize := &domain.RankedPrize{PrizeName: quickestOwnGoal, Rankings: []domain.Rank{}}
-
-	teams := domain.TeamCollection{teamA, teamB, teamC, teamD}
-	participants := domain.ParticipantCollection{participantA, participantB, participantC, participantD}
-
-	tt := []struct {
-		name       string
-		sweepstake *domain.Sweepstake
-		wantPrize  *domain.RankedPrize
-	}{
-		{
-			name: "valid sweepstake must produce the expected rankings",
+			name: "match decided on penalties must be considered a draw",
 			sweepstake: &domain.Sweepstake{
 				Tournament: &domain.Tournament{
 					Teams: teams,
 					Matches: domain.MatchCollection{
 						{
+							ID:        "A1",
 							Completed: true,
-							Timestamp: date1,
 							Home: domain.MatchCompetitor{
-								Team: teamA,
-								OwnGoals: []domain.MatchEvent{
-									{
-										Name:   "Lennon",
-										Minute: 90,
-										Offset: 1,
-									},
-									{
-										Name:   "McCartney",
-										Minute: 2,
-									},
-								},
+								Team:  teamA,
+								Goals: 1,
 							},
 							Away: domain.MatchCompetitor{
-								Team: teamB,
-								OwnGoals: []domain.MatchEvent{
-									{
-										Name:   "G.Harrison",
-										Minute: 90,
-									},
-								},
+								Team:  teamB,
+								Goals: 1,
 							},
 						},
-						// not completed, should be ignored
 						{
-							// completed is false
-							Timestamp: date2,
+							ID:                 "F",
+							Completed:          true,
+							Winner:             teamD,
+							DecidedOnPenalties: true,
 							Home: domain.MatchCompetitor{
-								Team: teamA,
-								OwnGoals: []domain.MatchEvent{
-									{
-										Name:   "Starr",
-										Minute: 123,
-									},
-								},
+								Team:  teamC,
+								Goals: 0,
 							},
 							Away: domain.MatchCompetitor{
-								Team: teamB,
-								OwnGoals: []domain.MatchEvent{
-									{
-										Name:   "B.Epstein",
-										Minute: 123,
-									},
-								},
+								Team:  teamD,
+								Goals: 0,
 							},
-						}, {
-							Completed: true,
-							Timestamp: date3,
+						},
+					},
+				},
+				Participants: participants,
+			},
+			wantPrize: &domain.OutrightPrize{
+				PrizeName:       woodenSpoon,
+				ParticipantName: "Marc Pugh (Team A)",
+				ImageURL:        "http://teamA.jpg",
+			},
+		},
+		{
+			name: "no completed matches must return default prize",
+			sweepstake: &domain.Sweepstake{
+				Tournament: &domain.Tournament{
+					Teams: teams,
+					Matches: domain.MatchCollection{
+						{
+							ID:        "A1",
+							Completed: false,
 							Home: domain.MatchCompetitor{
-								Team: teamC,
-								OwnGoals: []domain.MatchEvent{
-									{
-										Name:   "Johnny",
-										Minute: 46,
-									},
-									{
-										Name:   "Joey",
-										Minute: 45,
-									},
-								},
+								Team:  teamA,
+								Goals: 2,
 							},
 							Away: domain.MatchCompetitor{
-								Team: teamD,
-								OwnGoals: []domain.MatchEvent{
-									{
-										Name:   "DeeDee",
-										Minute: 45,
-										Offset: 4,
-									},
-									{
-										Name:   "Tommy",
-										Minute: 45,
-										Offset: 5,
-									},
-								},
+								Team:  teamB,
+								Goals: 0,
 							},
 						},
 					},
 				},
 				Participants: participants,
 			},
-			wantPrize: &domain.RankedPrize{
-				PrizeName: quickestOwnGoal,
-				Rankings: []domain.Rank{
-					{
-						Position:        1,
-						ImageURL:        "http://teamA.jpg",
-						ParticipantName: "Marc Pugh (Team A)",
-						Value:           "🙈 2' McCartney (vs Team B 26/05)",
-					},
-					{
-						Position:        2,
-						ImageURL:        "http://teamC.jpg",
-						ParticipantName: "Brett Pitman (Team C)",
-						Value:           "🙈 45' Joey (vs Team D 28/05)",
-					},
-					{
-						Position:        3,
-						ImageURL:        "http://teamD.jpg",
-						ParticipantName: "Shaun McDonald (Team D)",
-						Value:           "🙈 45'+4 DeeDee (vs Team C 28/05)",
-					},
-					{
-						Position:        4,
-						ImageURL:        "http://teamD.jpg",
-						ParticipantName: "Shaun McDonald (Team D)",
-						Value:           "🙈 45'+5 Tommy (vs Team C 28/05)",
-					},
-					{
-						Position:        5,
-						ImageURL:        "http://teamC.jpg",
-						ParticipantName: "Brett Pitman (Team C)",
-						Value:           "🙈 46' Johnny (vs Team D 28/05)",
-					},
-					{
-						Position:        6,
-						ImageURL:        "http://teamB.jpg",
-						ParticipantName: "Steve Fletcher (Team B)",
-						Value:           "🙈 90' G.Harrison (vs Team A 26/05)",
-					},
-					{
-						Position:        7,
-						ImageURL:        "http://teamA.jpg",
-						ParticipantName: "Marc Pugh (Team A)",
-						Value:           "🙈 90'+1 Lennon (vs Team B 26/05)",
-					},
+			wantPrize: defaultPrize,
+		},
+		{
+			name: "no matches must return default prize",
+			sweepstake: &domain.Sweepstake{
+				Tournament: &domain.Tournament{
+					Teams: teams,
+					// no matches
 				},
+				Participants: participants,
 			},
+			wantPrize: defaultPrize,
 		},
 		{
 			name:      "no sweepstake must return default prize",
@@ -814,108 +882,246 @@ func TestQuickestOwnGoal(t *testing.T) {
 
 	for _, tc := range tt {
 		t.Run(tc.name, func(t *testing.T) {
-			gotPrize := domain.QuickestOwnGoal(tc.sweepstake)
+			gotPrize := domain.WoodenSpoon(tc.sweepstake)
 			cmpDiff(t, tc.wantPrize, gotPrize)
 		})
 	}
 }
 
-func TestQuickestRedCard(t *testing.T) {
-	defaultPrize := &domain.RankedPrize{PrizeName: quickestRedCard, Rankings: []domain.Rank{}}
-
-	teams := domain.TeamCollection{teamA, teamB, teamC, teamD}
+func TestFirstEliminated(t *testing.T) {
+	defaultPrize := &domain.OutrightPrize{PrizeName: firstEliminated, ParticipantName: "TBC"}
 	participants := domain.ParticipantCollection{participantA, participantB, participantC, participantD}
 
+	newMatch := func(id string, stage domain.MatchStage, timestamp time.Time, home, away, winner *domain.Team) *domain.Match {
+		return &domain.Match{
+			ID:        id,
+			Stage:     stage,
+			Timestamp: timestamp,
+			Completed: true,
+			Home:      domain.MatchCompetitor{Team: home},
+			Away:      domain.MatchCompetitor{Team: away},
+			Winner:    winner,
+		}
+	}
+
+	notCompleted := newMatch("R16_0", domain.KnockoutStage, date1, teamA, teamD, teamA)
+	notCompleted.Completed = false
+
 	tt := []struct {
 		name       string
 		sweepstake *domain.Sweepstake
-		wantPrize  *domain.RankedPrize
+		wantPrize  *domain.OutrightPrize
 	}{
 		{
-			name: "valid sweepstake must produce the expected rankings",
+			name: "loser of earliest completed knockout match must win the prize",
 			sweepstake: &domain.Sweepstake{
 				Tournament: &domain.Tournament{
-					Teams: teams,
 					Matches: domain.MatchCollection{
-						{
-							Completed: true,
-							Timestamp: date1,
-							Home: domain.MatchCompetitor{
-								Team: teamA,
-								RedCards: []domain.MatchEvent{
-									{
-										Name:   "Lennon",
-										Minute: 90,
-										Offset: 1,
-									},
-									{
-										Name:   "McCartney",
-										Minute: 2,
-									},
-								},
+						newMatch("G1", domain.GroupStage, date1, teamA, teamC, teamA), // group stage, should be ignored
+						notCompleted,
+						newMatch("R16_2", domain.KnockoutStage, date3, teamA, teamB, teamA),
+						newMatch("R16_1", domain.KnockoutStage, date2, teamC, teamD, teamC),
+					},
+				},
+				Participants: participants,
+			},
+			wantPrize: &domain.OutrightPrize{
+				PrizeName:       firstEliminated,
+				ParticipantName: "Shaun McDonald (Team D)",
+				ImageURL:        "http://teamD.jpg",
+			},
+		},
+		{
+			name: "knockout matches that kick off at the same time must be tie-broken by match id",
+			sweepstake: &domain.Sweepstake{
+				Tournament: &domain.Tournament{
+					Matches: domain.MatchCollection{
+						newMatch("R16_2", domain.KnockoutStage, date2, teamA, teamB, teamA),
+						newMatch("R16_1", domain.KnockoutStage, date2, teamC, teamD, teamD),
+					},
+				},
+				Participants: participants,
+			},
+			wantPrize: &domain.OutrightPrize{
+				PrizeName:       firstEliminated,
+				ParticipantName: "Brett Pitman (Team C)",
+				ImageURL:        "http://teamC.jpg",
+			},
+		},
+		{
+			name: "no completed knockout matches must return default prize",
+			sweepstake: &domain.Sweepstake{
+				Tournament: &domain.Tournament{
+					Matches: domain.MatchCollection{
+						newMatch("G1", domain.GroupStage, date1, teamA, teamC, teamA),
+						notCompleted,
+					},
+				},
+				Participants: participants,
+			},
+			wantPrize: defaultPrize,
+		},
+		{
+			name: "completed knockout match without a winner must return default prize",
+			sweepstake: &domain.Sweepstake{
+				Tournament: &domain.Tournament{
+					Matches: domain.MatchCollection{
+						newMatch("R16_1", domain.KnockoutStage, date1, teamA, teamB, nil),
+					},
+				},
+				Participants: participants,
+			},
+			wantPrize: defaultPrize,
+		},
+		{
+			name:      "nil sweepstake must return default prize",
+			wantPrize: defaultPrize,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			gotPrize := domain.FirstEliminated(tc.sweepstake)
+			cmpDiff(t, tc.wantPrize, gotPrize)
+		})
+	}
+}
+
+func TestNewRankedPrizeGenerator(t *testing.T) {
+	valuesFn := func(s *domain.Sweepstake) []domain.RankValue {
+		return []domain.RankValue{
+			{Team: teamA, Value: 1},
+			{Team: teamB, Value: 3},
+			{Value: 5}, // team is not yet known, should be ignored
+			{Team: teamC, Value: 3},
+			{Team: teamD, Value: 2},
+		}
+	}
+
+	sweepstake := &domain.Sweepstake{
+		Tournament: &domain.Tournament{
+			Teams: domain.TeamCollection{teamA, teamB, teamC, teamD},
+		},
+		Participants: domain.ParticipantCollection{participantA, participantB, participantC, participantD},
+	}
+
+	tt := []struct {
+		name       string
+		order      domain.RankOrder
+		sweepstake *domain.Sweepstake
+		wantPrize  *domain.RankedPrize
+	}{
+		{
+			name:       "descending order must rank highest values first",
+			order:      domain.RankDescending,
+			sweepstake: sweepstake,
+			wantPrize: &domain.RankedPrize{
+				PrizeName: "Test Prize",
+				Rankings: []domain.Rank{
+					{Position: 1, ImageURL: "http://teamB.jpg", ParticipantName: "Steve Fletcher (Team B)", Value: "⚽️ 3"},
+					{Position: 2, ImageURL: "http://teamC.jpg", ParticipantName: "Brett Pitman (Team C)", Value: "⚽️ 3"},
+					{Position: 3, ImageURL: "http://teamD.jpg", ParticipantName: "Shaun McDonald (Team D)", Value: "⚽️ 2"},
+					{Position: 4, ImageURL: "http://teamA.jpg", ParticipantName: "Marc Pugh (Team A)", Value: "⚽️ 1"},
+				},
+			},
+		},
+		{
+			name:       "ascending order must rank lowest values first",
+			order:      domain.RankAscending,
+			sweepstake: sweepstake,
+			wantPrize: &domain.RankedPrize{
+				PrizeName: "Test Prize",
+				Rankings: []domain.Rank{
+					{Position: 1, ImageURL: "http://teamA.jpg", ParticipantName: "Marc Pugh (Team A)", Value: "⚽️ 1"},
+					{Position: 2, ImageURL: "http://teamD.jpg", ParticipantName: "Shaun McDonald (Team D)", Value: "⚽️ 2"},
+					{Position: 3, ImageURL: "http://teamB.jpg", ParticipantName: "Steve Fletcher (Team B)", Value: "⚽️ 3"},
+					{Position: 4, ImageURL: "http://teamC.jpg", ParticipantName: "Brett Pitman (Team C)", Value: "⚽️ 3"},
+				},
+			},
+		},
+		{
+			name:      "nil sweepstake must produce the expected prize without rankings",
+			order:     domain.RankDescending,
+			wantPrize: &domain.RankedPrize{PrizeName: "Test Prize", Rankings: []domain.Rank{}},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			generator := domain.NewRankedPrizeGenerator("Test Prize", "most_goals_conceded", tc.order, valuesFn)
+			gotPrize := generator(tc.sweepstake)
+			cmpDiff(t, tc.wantPrize, gotPrize)
+		})
+	}
+}
+
+func TestMostGoalsConceded(t *testing.T) {
+	defaultPrize := &domain.RankedPrize{PrizeName: mostGoalsConceded, Rankings: []domain.Rank{}}
+
+	teams := domain.TeamCollection{teamA, teamB, teamC, teamD}
+	participants := domain.ParticipantCollection{participantA, participantB, participantC, participantD}
+
+	tt := []struct {
+		name         string
+		sweepstake   *domain.Sweepstake
+		wantPrize    *domain.RankedPrize
+		wantWarnings []string
+	}{
+		{
+			name: "valid sweepstake must produce the expected rankings",
+			sweepstake: &domain.Sweepstake{
+				Tournament: &domain.Tournament{
+					Teams: teams,
+					Matches: domain.MatchCollection{
+						// teamA = 1 (1)
+						// teamB = 2 (2)
+						{
+							Completed: true,
+							Home: domain.MatchCompetitor{
+								Team:  teamA,
+								Goals: 2,
 							},
 							Away: domain.MatchCompetitor{
-								Team: teamB,
-								RedCards: []domain.MatchEvent{
-									{
-										Name:   "G.Harrison",
-										Minute: 90,
-									},
-								},
+								Team:  teamB,
+								Goals: 1,
 							},
 						},
 						// not completed, should be ignored
 						{
 							// completed is false
-							Timestamp: date2,
 							Home: domain.MatchCompetitor{
-								Team: teamA,
-								RedCards: []domain.MatchEvent{
-									{
-										Name:   "Starr",
-										Minute: 123,
-									},
-								},
+								Team:  teamA,
+								Goals: 99,
 							},
 							Away: domain.MatchCompetitor{
-								Team: teamB,
-								RedCards: []domain.MatchEvent{
-									{
-										Name:   "B.Epstein",
-										Minute: 123,
-									},
-								},
+								Team:  teamB,
+								Goals: 99,
 							},
-						}, {
+						},
+						// teamB = 3 (5)
+						// teamC = 2 (2)
+						{
 							Completed: true,
-							Timestamp: date3,
 							Home: domain.MatchCompetitor{
-								Team: teamC,
-								RedCards: []domain.MatchEvent{
-									{
-										Name:   "Johnny",
-										Minute: 46,
-									},
-									{
-										Name:   "Joey",
-										Minute: 45,
-									},
-								},
+								Team:  teamB,
+								Goals: 2,
 							},
 							Away: domain.MatchCompetitor{
-								Team: teamD,
-								RedCards: []domain.MatchEvent{
-									{
-										Name:   "DeeDee",
-										Minute: 45,
-										Offset: 4,
-									},
-									{
-										Name:   "Tommy",
-										Minute: 45,
-										Offset: 5,
-									},
-								},
+								Team:  teamC,
+								Goals: 3,
+							},
+						},
+						// teamB = 1 (6)
+						// teamD = 0 (0)
+						{
+							Completed: true,
+							Home: domain.MatchCompetitor{
+								Team:  teamB,
+								Goals: 0,
+							},
+							Away: domain.MatchCompetitor{
+								Team:  teamD,
+								Goals: 1,
 							},
 						},
 					},
@@ -923,64 +1129,2887 @@ func TestQuickestRedCard(t *testing.T) {
 				Participants: participants,
 			},
 			wantPrize: &domain.RankedPrize{
-				PrizeName: quickestRedCard,
+				PrizeName: mostGoalsConceded,
 				Rankings: []domain.Rank{
 					{
 						Position:        1,
-						ImageURL:        "http://teamA.jpg",
-						ParticipantName: "Marc Pugh (Team A)",
-						Value:           "🟥 2' McCartney (vs Team B 26/05)",
+						ImageURL:        "http://teamB.jpg",
+						ParticipantName: "Steve Fletcher (Team B)",
+						Value:           "⚽️ 6",
 					},
 					{
 						Position:        2,
 						ImageURL:        "http://teamC.jpg",
 						ParticipantName: "Brett Pitman (Team C)",
-						Value:           "🟥 45' Joey (vs Team D 28/05)",
+						Value:           "⚽️ 2",
 					},
 					{
 						Position:        3,
-						ImageURL:        "http://teamD.jpg",
-						ParticipantName: "Shaun McDonald (Team D)",
-						Value:           "🟥 45'+4 DeeDee (vs Team C 28/05)",
+						ImageURL:        "http://teamA.jpg",
+						ParticipantName: "Marc Pugh (Team A)",
+						Value:           "⚽️ 1",
 					},
-					{
-						Position:        4,
-						ImageURL:        "http://teamD.jpg",
-						ParticipantName: "Shaun McDonald (Team D)",
-						Value:           "🟥 45'+5 Tommy (vs Team C 28/05)",
+					// teamD do not rank
+				},
+			},
+		},
+		{
+			name: "custom value template must render the expected values",
+			sweepstake: &domain.Sweepstake{
+				Tournament: &domain.Tournament{
+					Teams: teams,
+					Matches: domain.MatchCollection{
+						{
+							Completed: true,
+							Home:      domain.MatchCompetitor{Team: teamA, Goals: 2},
+							Away:      domain.MatchCompetitor{Team: teamB, Goals: 1},
+						},
 					},
-					{
-						Position:        5,
-						ImageURL:        "http://teamC.jpg",
-						ParticipantName: "Brett Pitman (Team C)",
-						Value:           "🟥 46' Johnny (vs Team D 28/05)",
+					ValueTemplates: map[string]string{
+						"most_goals_conceded": "{{ .Value }} conceded by {{ .Team.Name }}",
+						"most_yellow_cards":   "not used by this prize",
 					},
+				},
+				Participants: participants,
+			},
+			wantPrize: &domain.RankedPrize{
+				PrizeName: mostGoalsConceded,
+				Rankings: []domain.Rank{
 					{
-						Position:        6,
+						Position:        1,
 						ImageURL:        "http://teamB.jpg",
 						ParticipantName: "Steve Fletcher (Team B)",
-						Value:           "🟥 90' G.Harrison (vs Team A 26/05)",
+						Value:           "2 conceded by Team B",
 					},
 					{
-						Position:        7,
+						Position:        2,
 						ImageURL:        "http://teamA.jpg",
 						ParticipantName: "Marc Pugh (Team A)",
-						Value:           "🟥 90'+1 Lennon (vs Team B 26/05)",
+						Value:           "1 conceded by Team A",
 					},
 				},
 			},
 		},
 		{
-			name:      "no sweepstake must return default prize",
-			wantPrize: defaultPrize,
-			// nil sweepstake
-		},
-	}
-
-	for _, tc := range tt {
-		t.Run(tc.name, func(t *testing.T) {
-			gotPrize := domain.QuickestRedCard(tc.sweepstake)
-			cmpDiff(t, tc.wantPrize, gotPrize)
-		})
-	}
+			name: "custom value template that cannot be rendered must fall back to default value template",
+			sweepstake: &domain.Sweepstake{
+				Tournament: &domain.Tournament{
+					Teams: teams,
+					Matches: domain.MatchCollection{
+						{
+							Completed: true,
+							Home:      domain.MatchCompetitor{Team: teamA, Goals: 0},
+							Away:      domain.MatchCompetitor{Team: teamB, Goals: 1},
+						},
+					},
+					ValueTemplates: map[string]string{
+						"most_goals_conceded": "{{ .NotAField }}",
+					},
+				},
+				Participants: participants,
+			},
+			wantPrize: &domain.RankedPrize{
+				PrizeName: mostGoalsConceded,
+				Rankings: []domain.Rank{
+					{
+						Position:        1,
+						ImageURL:        "http://teamA.jpg",
+						ParticipantName: "Marc Pugh (Team A)",
+						Value:           "⚽️ 1",
+					},
+				},
+			},
+			wantWarnings: []string{
+				`value template 'most_goals_conceded': cannot execute: template: most_goals_conceded:1:3: executing "most_goals_conceded" at <.NotAField>: can't evaluate field NotAField in type domain.RankValue`,
+			},
+		},
+		{
+			name: "custom value template that cannot be parsed must fall back to default value template",
+			sweepstake: &domain.Sweepstake{
+				Tournament: &domain.Tournament{
+					Teams: teams,
+					Matches: domain.MatchCollection{
+						{
+							Completed: true,
+							Home:      domain.MatchCompetitor{Team: teamA, Goals: 0},
+							Away:      domain.MatchCompetitor{Team: teamB, Goals: 1},
+						},
+					},
+					ValueTemplates: map[string]string{
+						"most_goals_conceded": "{{ .Value",
+					},
+				},
+				Participants: participants,
+			},
+			wantPrize: &domain.RankedPrize{
+				PrizeName: mostGoalsConceded,
+				Rankings: []domain.Rank{
+					{
+						Position:        1,
+						ImageURL:        "http://teamA.jpg",
+						ParticipantName: "Marc Pugh (Team A)",
+						Value:           "⚽️ 1",
+					},
+				},
+			},
+			wantWarnings: []string{
+				"value template 'most_goals_conceded': cannot parse: template: most_goals_conceded:1: unclosed action",
+			},
+		},
+		{
+			name: "no matches must return default prize",
+			sweepstake: &domain.Sweepstake{
+				Tournament: &domain.Tournament{
+					Teams: domain.TeamCollection{teamA, teamB},
+					// no matches
+				},
+				Participants: domain.ParticipantCollection{participantA, participantB},
+			},
+			wantPrize: defaultPrize,
+		},
+		{
+			name:      "no sweepstake must return default prize",
+			wantPrize: defaultPrize,
+			// nil sweepstake
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			gotPrize := domain.MostGoalsConceded(tc.sweepstake)
+			cmpDiff(t, tc.wantPrize, gotPrize)
+
+			var gotWarnings []string
+			for _, err := range tc.sweepstake.RenderWarnings() {
+				gotWarnings = append(gotWarnings, err.Error())
+			}
+			cmpDiff(t, tc.wantWarnings, gotWarnings)
+		})
+	}
+}
+
+func TestMostGoalsInKnockouts(t *testing.T) {
+	defaultPrize := &domain.RankedPrize{PrizeName: mostGoalsInKnockouts, Rankings: []domain.Rank{}}
+
+	teams := domain.TeamCollection{teamA, teamB, teamC, teamD}
+	participants := domain.ParticipantCollection{participantA, participantB, participantC, participantD}
+
+	tt := []struct {
+		name       string
+		sweepstake *domain.Sweepstake
+		wantPrize  *domain.RankedPrize
+	}{
+		{
+			name: "valid sweepstake must produce the expected rankings",
+			sweepstake: &domain.Sweepstake{
+				Tournament: &domain.Tournament{
+					Teams: teams,
+					Matches: domain.MatchCollection{
+						// group stage, should be ignored
+						{
+							Stage:     domain.GroupStage,
+							Completed: true,
+							Home: domain.MatchCompetitor{
+								Team:  teamD,
+								Goals: 7,
+							},
+							Away: domain.MatchCompetitor{
+								Team:  teamA,
+								Goals: 5,
+							},
+						},
+						// teamA = 2 (2)
+						// teamB = 1 (1)
+						{
+							Stage:     domain.KnockoutStage,
+							Completed: true,
+							Home: domain.MatchCompetitor{
+								Team:  teamA,
+								Goals: 2,
+							},
+							Away: domain.MatchCompetitor{
+								Team:  teamB,
+								Goals: 1,
+							},
+						},
+						// not completed, should be ignored
+						{
+							Stage: domain.KnockoutStage,
+							// completed is false
+							Home: domain.MatchCompetitor{
+								Team:  teamC,
+								Goals: 99,
+							},
+							Away: domain.MatchCompetitor{
+								Team:  teamD,
+								Goals: 99,
+							},
+						},
+						// teamA = 1 (3)
+						// teamC = 3 (3)
+						{
+							Stage:     domain.KnockoutStage,
+							Completed: true,
+							Home: domain.MatchCompetitor{
+								Team:  teamC,
+								Goals: 3,
+							},
+							Away: domain.MatchCompetitor{
+								Team:  teamA,
+								Goals: 1,
+							},
+						},
+					},
+				},
+				Participants: participants,
+			},
+			wantPrize: &domain.RankedPrize{
+				PrizeName: mostGoalsInKnockouts,
+				Rankings: []domain.Rank{
+					{
+						Position:        1,
+						ImageURL:        "http://teamA.jpg",
+						ParticipantName: "Marc Pugh (Team A)",
+						Value:           "⚽️ 3",
+					},
+					{
+						Position:        2,
+						ImageURL:        "http://teamC.jpg",
+						ParticipantName: "Brett Pitman (Team C)",
+						Value:           "⚽️ 3",
+					},
+					{
+						Position:        3,
+						ImageURL:        "http://teamB.jpg",
+						ParticipantName: "Steve Fletcher (Team B)",
+						Value:           "⚽️ 1",
+					},
+					// teamD do not rank
+				},
+			},
+		},
+		{
+			name: "no matches must return default prize",
+			sweepstake: &domain.Sweepstake{
+				Tournament: &domain.Tournament{
+					Teams: domain.TeamCollection{teamA, teamB},
+					// no matches
+				},
+				Participants: domain.ParticipantCollection{participantA, participantB},
+			},
+			wantPrize: defaultPrize,
+		},
+		{
+			name:      "no sweepstake must return default prize",
+			wantPrize: defaultPrize,
+			// nil sweepstake
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			gotPrize := domain.MostGoalsInKnockouts(tc.sweepstake)
+			cmpDiff(t, tc.wantPrize, gotPrize)
+		})
+	}
+}
+
+func TestGoalRush(t *testing.T) {
+	defaultPrize := &domain.RankedPrize{PrizeName: goalRush, Rankings: []domain.Rank{}}
+
+	teams := domain.TeamCollection{teamA, teamB, teamC, teamD}
+	participants := domain.ParticipantCollection{participantA, participantB, participantC, participantD}
+
+	tt := []struct {
+		name       string
+		sweepstake *domain.Sweepstake
+		wantPrize  *domain.RankedPrize
+	}{
+		{
+			name: "first-half and second-half bursts must produce the expected rankings",
+			sweepstake: &domain.Sweepstake{
+				Tournament: &domain.Tournament{
+					Teams: teams,
+					Matches: domain.MatchCollection{
+						{
+							Completed: true,
+							Timestamp: date1,
+							Home: domain.MatchCompetitor{
+								Team: teamA,
+								Scorers: []domain.MatchEvent{
+									{
+										Name:   "Lennon",
+										Minute: 10,
+									},
+									{
+										Name:   "Lennon",
+										Minute: 30,
+									},
+									{
+										Name:   "McCartney",
+										Minute: 45,
+										Offset: 2,
+									},
+									{
+										Name:   "Starr",
+										Minute: 60,
+									},
+								},
+							},
+							Away: domain.MatchCompetitor{
+								Team: teamB,
+								Scorers: []domain.MatchEvent{
+									{
+										Name:   "G.Harrison",
+										Minute: 50,
+									},
+									{
+										Name:   "G.Harrison",
+										Minute: 70,
+									},
+								},
+							},
+						},
+						{
+							Completed: true,
+							Timestamp: date2,
+							Home: domain.MatchCompetitor{
+								Team: teamC,
+								Scorers: []domain.MatchEvent{
+									{
+										Name:   "Mercury",
+										Minute: 100,
+									},
+								},
+							},
+							Away: domain.MatchCompetitor{
+								Team: teamD,
+								Scorers: []domain.MatchEvent{
+									{
+										Name:   "Bowie",
+										Minute: 46,
+									},
+									{
+										Name:   "Bowie",
+										Minute: 80,
+									},
+									{
+										Name:   "Jagger",
+										Minute: 90,
+										Offset: 3,
+									},
+									{
+										Name:   "Bowie",
+										Minute: 88,
+									},
+								},
+							},
+						},
+						// not completed, should be ignored
+						{
+							// completed is false
+							Timestamp: date3,
+							Home: domain.MatchCompetitor{
+								Team: teamB,
+								Scorers: []domain.MatchEvent{
+									{
+										Name:   "G.Harrison",
+										Minute: 1,
+									},
+									{
+										Name:   "G.Harrison",
+										Minute: 2,
+									},
+									{
+										Name:   "G.Harrison",
+										Minute: 3,
+									},
+									{
+										Name:   "G.Harrison",
+										Minute: 4,
+									},
+									{
+										Name:   "G.Harrison",
+										Minute: 5,
+									},
+								},
+							},
+							Away: domain.MatchCompetitor{
+								Team: teamC,
+							},
+						},
+					},
+				},
+				Participants: participants,
+			},
+			wantPrize: &domain.RankedPrize{
+				PrizeName: goalRush,
+				Rankings: []domain.Rank{
+					{
+						Position:        1,
+						ImageURL:        "http://teamD.jpg",
+						ParticipantName: "Shaun McDonald (Team D)",
+						Value:           "⚡ 4 in H2 (vs Team C 27/05)",
+					},
+					{
+						Position:        2,
+						ImageURL:        "http://teamA.jpg",
+						ParticipantName: "Marc Pugh (Team A)",
+						Value:           "⚡ 3 in H1 (vs Team B 26/05)",
+					},
+					{
+						Position:        3,
+						ImageURL:        "http://teamB.jpg",
+						ParticipantName: "Steve Fletcher (Team B)",
+						Value:           "⚡ 2 in H2 (vs Team A 26/05)",
+					},
+					{
+						Position:        4,
+						ImageURL:        "http://teamC.jpg",
+						ParticipantName: "Brett Pitman (Team C)",
+						Value:           "⚡ 1 in ET1 (vs Team D 27/05)",
+					},
+				},
+			},
+		},
+		{
+			name: "bursts with identical goals must be ranked by match timestamp then team name",
+			sweepstake: &domain.Sweepstake{
+				Tournament: &domain.Tournament{
+					Teams: teams,
+					Matches: domain.MatchCollection{
+						{
+							Completed: true,
+							Timestamp: date2,
+							Home: domain.MatchCompetitor{
+								Team: teamA,
+								Scorers: []domain.MatchEvent{
+									{
+										Name:   "Lennon",
+										Minute: 10,
+									},
+									{
+										Name:   "Lennon",
+										Minute: 20,
+									},
+								},
+							},
+							Away: domain.MatchCompetitor{
+								Team: teamD,
+							},
+						},
+						{
+							Completed: true,
+							Timestamp: date1,
+							Home: domain.MatchCompetitor{
+								Team: teamC,
+								Scorers: []domain.MatchEvent{
+									{
+										Name:   "Mercury",
+										Minute: 50,
+									},
+									{
+										Name:   "Mercury",
+										Minute: 60,
+									},
+								},
+							},
+							Away: domain.MatchCompetitor{
+								Team: teamB,
+								Scorers: []domain.MatchEvent{
+									{
+										Name:   "G.Harrison",
+										Minute: 75,
+									},
+									{
+										Name:   "G.Harrison",
+										Minute: 85,
+									},
+								},
+							},
+						},
+					},
+				},
+				Participants: participants,
+			},
+			wantPrize: &domain.RankedPrize{
+				PrizeName: goalRush,
+				Rankings: []domain.Rank{
+					{
+						Position:        1,
+						ImageURL:        "http://teamB.jpg",
+						ParticipantName: "Steve Fletcher (Team B)",
+						Value:           "⚡ 2 in H2 (vs Team C 26/05)",
+					},
+					{
+						Position:        2,
+						ImageURL:        "http://teamC.jpg",
+						ParticipantName: "Brett Pitman (Team C)",
+						Value:           "⚡ 2 in H2 (vs Team B 26/05)",
+					},
+					{
+						Position:        3,
+						ImageURL:        "http://teamA.jpg",
+						ParticipantName: "Marc Pugh (Team A)",
+						Value:           "⚡ 2 in H1 (vs Team D 27/05)",
+					},
+				},
+			},
+		},
+		{
+			name: "team with multiple bursts must be ranked by its best burst only",
+			sweepstake: &domain.Sweepstake{
+				Tournament: &domain.Tournament{
+					Teams: teams,
+					Matches: domain.MatchCollection{
+						{
+							Completed: true,
+							Timestamp: date1,
+							Home: domain.MatchCompetitor{
+								Team: teamA,
+								Scorers: []domain.MatchEvent{
+									{
+										Name:   "Lennon",
+										Minute: 10,
+									},
+									{
+										Name:   "Lennon",
+										Minute: 50,
+									},
+									{
+										Name:   "Lennon",
+										Minute: 60,
+									},
+								},
+							},
+							Away: domain.MatchCompetitor{
+								Team: teamB,
+							},
+						},
+						{
+							Completed: true,
+							Timestamp: date2,
+							Home: domain.MatchCompetitor{
+								Team: teamA,
+								Scorers: []domain.MatchEvent{
+									{
+										Name:   "Lennon",
+										Minute: 10,
+									},
+								},
+							},
+							Away: domain.MatchCompetitor{
+								Team: teamC,
+							},
+						},
+					},
+				},
+				Participants: participants,
+			},
+			wantPrize: &domain.RankedPrize{
+				PrizeName: goalRush,
+				Rankings: []domain.Rank{
+					{
+						Position:        1,
+						ImageURL:        "http://teamA.jpg",
+						ParticipantName: "Marc Pugh (Team A)",
+						Value:           "⚡ 2 in H2 (vs Team B 26/05)",
+					},
+				},
+			},
+		},
+		{
+			name: "matches without goal events must return default prize",
+			sweepstake: &domain.Sweepstake{
+				Tournament: &domain.Tournament{
+					Teams: teams,
+					Matches: domain.MatchCollection{
+						{
+							Completed: true,
+							Timestamp: date1,
+							Home: domain.MatchCompetitor{
+								Team:  teamA,
+								Goals: 3,
+							},
+							Away: domain.MatchCompetitor{
+								Team:  teamB,
+								Goals: 2,
+							},
+						},
+					},
+				},
+				Participants: participants,
+			},
+			wantPrize: defaultPrize,
+		},
+		{
+			name: "no matches must return default prize",
+			sweepstake: &domain.Sweepstake{
+				Tournament: &domain.Tournament{
+					Teams: teams,
+					// no matches
+				},
+				Participants: participants,
+			},
+			wantPrize: defaultPrize,
+		},
+		{
+			name:      "no sweepstake must return default prize",
+			wantPrize: defaultPrize,
+			// nil sweepstake
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			gotPrize := domain.GoalRush(tc.sweepstake)
+			cmpDiff(t, tc.wantPrize, gotPrize)
+		})
+	}
+}
+
+func TestLongestWinningStreak(t *testing.T) {
+	defaultPrize := &domain.RankedPrize{PrizeName: longestWinningStreak, Rankings: []domain.Rank{}}
+
+	teams := domain.TeamCollection{teamC, teamB, teamA, teamD} // not in order of name
+	participants := domain.ParticipantCollection{participantA, participantB, participantC, participantD}
+
+	newMatch := func(timestamp time.Time, home, away, winner *domain.Team) *domain.Match {
+		return &domain.Match{
+			Timestamp: timestamp,
+			Completed: true,
+			Home:      domain.MatchCompetitor{Team: home},
+			Away:      domain.MatchCompetitor{Team: away},
+			Winner:    winner,
+		}
+	}
+
+	tt := []struct {
+		name       string
+		sweepstake *domain.Sweepstake
+		wantPrize  *domain.RankedPrize
+	}{
+		{
+			name: "valid sweepstake must produce the expected rankings",
+			sweepstake: &domain.Sweepstake{
+				Tournament: &domain.Tournament{
+					Teams: teams,
+					Matches: domain.MatchCollection{
+						// matches are not in order of kick-off
+						newMatch(date3.Add(24*time.Hour), teamA, teamB, teamA), // teamA = 2 (2nd win since draw)
+						newMatch(date1, teamA, teamB, teamA),                   // teamA = 1
+						newMatch(date2, teamA, teamC, nil),                     // draw breaks streaks of teamA and teamC
+						newMatch(date3, teamD, teamA, teamA),                   // teamA = 1 (1st win since draw)
+						newMatch(date1.Add(2*time.Hour), teamC, teamD, teamC),  // teamC = 1
+						newMatch(date1.Add(4*time.Hour), teamB, teamC, teamC),  // teamC = 2
+						newMatch(date3.Add(2*time.Hour), teamB, teamD, teamB),  // teamB = 1
+						// not completed, should be ignored
+						{
+							Timestamp: date3.Add(48 * time.Hour),
+							Home:      domain.MatchCompetitor{Team: teamB},
+							Away:      domain.MatchCompetitor{Team: teamD},
+							Winner:    teamB,
+						},
+					},
+				},
+				Participants: participants,
+			},
+			wantPrize: &domain.RankedPrize{
+				PrizeName: longestWinningStreak,
+				Rankings: []domain.Rank{
+					{
+						Position:        1,
+						ImageURL:        "http://teamA.jpg",
+						ParticipantName: "Marc Pugh (Team A)",
+						Value:           "🔥 2 wins",
+					},
+					// teamC has identical streak so is ranked by name
+					{
+						Position:        2,
+						ImageURL:        "http://teamC.jpg",
+						ParticipantName: "Brett Pitman (Team C)",
+						Value:           "🔥 2 wins",
+					},
+					{
+						Position:        3,
+						ImageURL:        "http://teamB.jpg",
+						ParticipantName: "Steve Fletcher (Team B)",
+						Value:           "🔥 1 win",
+					},
+					// teamD do not rank
+				},
+			},
+		},
+		{
+			name: "no matches must return default prize",
+			sweepstake: &domain.Sweepstake{
+				Tournament: &domain.Tournament{
+					Teams: domain.TeamCollection{teamA, teamB},
+					// no matches
+				},
+				Participants: domain.ParticipantCollection{participantA, participantB},
+			},
+			wantPrize: defaultPrize,
+		},
+		{
+			name:      "no sweepstake must return default prize",
+			wantPrize: defaultPrize,
+			// nil sweepstake
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			gotPrize := domain.LongestWinningStreak(tc.sweepstake)
+			cmpDiff(t, tc.wantPrize, gotPrize)
+		})
+	}
+}
+
+func TestLongestDefensiveRun(t *testing.T) {
+	defaultPrize := &domain.RankedPrize{PrizeName: longestDefensiveRun, Rankings: []domain.Rank{}}
+
+	teams := domain.TeamCollection{teamC, teamB, teamA, teamD} // not in order of name
+	participants := domain.ParticipantCollection{participantA, participantB, participantC, participantD}
+
+	newMatch := func(timestamp time.Time, home *domain.Team, homeGoals uint8, away *domain.Team, awayGoals uint8) *domain.Match {
+		return &domain.Match{
+			Timestamp: timestamp,
+			Completed: true,
+			Home:      domain.MatchCompetitor{Team: home, Goals: homeGoals},
+			Away:      domain.MatchCompetitor{Team: away, Goals: awayGoals},
+		}
+	}
+
+	// goals conceded in a penalty shoot-out do not break a run
+	shootOut := newMatch(date2, teamA, 0, teamC, 0) // teamA = 2, teamC = 2
+	shootOut.DecidedOnPenalties = true
+	shootOut.HomePens = 5
+	shootOut.AwayPens = 4
+
+	tt := []struct {
+		name       string
+		sweepstake *domain.Sweepstake
+		wantPrize  *domain.RankedPrize
+	}{
+		{
+			name: "valid sweepstake must produce the expected rankings",
+			sweepstake: &domain.Sweepstake{
+				Tournament: &domain.Tournament{
+					Teams: teams,
+					Matches: domain.MatchCollection{
+						// matches are not in order of kick-off
+						newMatch(date3.Add(24*time.Hour), teamA, 2, teamB, 0), // teamA = 1 (1st since conceding)
+						newMatch(date1, teamA, 1, teamB, 0),                   // teamA = 1
+						shootOut,
+						newMatch(date3, teamD, 2, teamA, 1),                  // conceded goals break runs of teamA and teamD
+						newMatch(date1.Add(2*time.Hour), teamC, 0, teamD, 0), // teamC = 1, teamD = 1
+						newMatch(date3.Add(2*time.Hour), teamB, 3, teamD, 0), // teamB = 1
+						// not completed, should be ignored
+						{
+							Timestamp: date3.Add(48 * time.Hour),
+							Home:      domain.MatchCompetitor{Team: teamB},
+							Away:      domain.MatchCompetitor{Team: teamC},
+						},
+					},
+				},
+				Participants: participants,
+			},
+			wantPrize: &domain.RankedPrize{
+				PrizeName: longestDefensiveRun,
+				Rankings: []domain.Rank{
+					{
+						Position:        1,
+						ImageURL:        "http://teamA.jpg",
+						ParticipantName: "Marc Pugh (Team A)",
+						Value:           "🛡 2 games",
+					},
+					// teamC has identical run so is ranked by name
+					{
+						Position:        2,
+						ImageURL:        "http://teamC.jpg",
+						ParticipantName: "Brett Pitman (Team C)",
+						Value:           "🛡 2 games",
+					},
+					{
+						Position:        3,
+						ImageURL:        "http://teamB.jpg",
+						ParticipantName: "Steve Fletcher (Team B)",
+						Value:           "🛡 1 game",
+					},
+					{
+						Position:        4,
+						ImageURL:        "http://teamD.jpg",
+						ParticipantName: "Shaun McDonald (Team D)",
+						Value:           "🛡 1 game",
+					},
+				},
+			},
+		},
+		{
+			name: "no matches must return default prize",
+			sweepstake: &domain.Sweepstake{
+				Tournament: &domain.Tournament{
+					Teams: domain.TeamCollection{teamA, teamB},
+					// no matches
+				},
+				Participants: domain.ParticipantCollection{participantA, participantB},
+			},
+			wantPrize: defaultPrize,
+		},
+		{
+			name:      "no sweepstake must return default prize",
+			wantPrize: defaultPrize,
+			// nil sweepstake
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			gotPrize := domain.LongestDefensiveRun(tc.sweepstake)
+			cmpDiff(t, tc.wantPrize, gotPrize)
+		})
+	}
+}
+
+func TestMostComebackWins(t *testing.T) {
+	defaultPrize := &domain.RankedPrize{PrizeName: mostComebackWins, Rankings: []domain.Rank{}}
+
+	teams := domain.TeamCollection{teamA, teamB, teamC, teamD}
+	participants := domain.ParticipantCollection{participantA, participantB, participantC, participantD}
+
+	// newCompetitor returns a competitor with the provided goals and scorer events
+	newCompetitor := func(team *domain.Team, goals uint8, scorers ...domain.MatchEvent) domain.MatchCompetitor {
+		return domain.MatchCompetitor{Team: team, Goals: goals, Scorers: scorers}
+	}
+
+	newMatch := func(timestamp time.Time, home, away domain.MatchCompetitor) *domain.Match {
+		return &domain.Match{
+			Timestamp: timestamp,
+			Completed: true,
+			Home:      home,
+			Away:      away,
+		}
+	}
+
+	wireToWire := newMatch(date1,
+		newCompetitor(teamC, 2, domain.MatchEvent{Name: "Mercury", Minute: 10}, domain.MatchEvent{Name: "May", Minute: 20}),
+		newCompetitor(teamD, 0),
+	)
+
+	tt := []struct {
+		name       string
+		sweepstake *domain.Sweepstake
+		wantPrize  *domain.RankedPrize
+	}{
+		{
+			name: "valid sweepstake must produce the expected rankings",
+			sweepstake: &domain.Sweepstake{
+				Tournament: &domain.Tournament{
+					Teams: teams,
+					Matches: domain.MatchCollection{
+						// teamA trails 0-1 then wins 2-1 (comeback)
+						newMatch(date1,
+							newCompetitor(teamA, 2, domain.MatchEvent{Name: "Lennon", Minute: 30}, domain.MatchEvent{Name: "Starr", Minute: 80}),
+							newCompetitor(teamB, 1, domain.MatchEvent{Name: "G.Harrison", Minute: 10}),
+						),
+						// teamC leads from start to finish (not a comeback)
+						wireToWire,
+						// teamB trails 0-1 then wins 2-1 with the help of an own goal (comeback)
+						{
+							Timestamp: date2,
+							Completed: true,
+							Home: domain.MatchCompetitor{
+								Team:     teamD,
+								Goals:    1,
+								Scorers:  []domain.MatchEvent{{Name: "Bowie", Minute: 5}},
+								OwnGoals: []domain.MatchEvent{{Name: "Jagger", Minute: 60}},
+							},
+							Away: newCompetitor(teamB, 2, domain.MatchEvent{Name: "G.Harrison", Minute: 85}),
+						},
+						// teamA trails 0-1 in first-half stopped time then wins 2-1 (comeback)
+						newMatch(date2.Add(2*time.Hour),
+							newCompetitor(teamB, 1, domain.MatchEvent{Name: "G.Harrison", Minute: 45, Offset: 2}),
+							newCompetitor(teamA, 2, domain.MatchEvent{Name: "Lennon", Minute: 46}, domain.MatchEvent{Name: "McCartney", Minute: 90, Offset: 1}),
+						),
+						// teamA equalises then wins 2-1 having never trailed (not a comeback)
+						newMatch(date3,
+							newCompetitor(teamA, 2, domain.MatchEvent{Name: "Lennon", Minute: 10}, domain.MatchEvent{Name: "Lennon", Minute: 70}),
+							newCompetitor(teamD, 1, domain.MatchEvent{Name: "Bowie", Minute: 20}),
+						),
+						// goals at an identical minute are simultaneous, so teamC never trails (not a comeback)
+						newMatch(date3.Add(2*time.Hour),
+							newCompetitor(teamC, 2, domain.MatchEvent{Name: "Mercury", Minute: 30}, domain.MatchEvent{Name: "Mercury", Minute: 60}),
+							newCompetitor(teamA, 1, domain.MatchEvent{Name: "Lennon", Minute: 30}),
+						),
+						// goal events do not account for every goal, should be ignored
+						newMatch(date3.Add(4*time.Hour),
+							newCompetitor(teamD, 2, domain.MatchEvent{Name: "Bowie", Minute: 80}),
+							newCompetitor(teamC, 1, domain.MatchEvent{Name: "Mercury", Minute: 10}),
+						),
+						// decided on penalties, should be ignored
+						{
+							Timestamp:          date3.Add(6 * time.Hour),
+							Completed:          true,
+							Home:               newCompetitor(teamD, 1, domain.MatchEvent{Name: "Bowie", Minute: 80}),
+							Away:               newCompetitor(teamC, 1, domain.MatchEvent{Name: "Mercury", Minute: 10}),
+							Winner:             teamD,
+							DecidedOnPenalties: true,
+						},
+						// not completed, should be ignored
+						{
+							// completed is false
+							Timestamp: date3.Add(24 * time.Hour),
+							Home:      newCompetitor(teamD, 2, domain.MatchEvent{Name: "Bowie", Minute: 50}, domain.MatchEvent{Name: "Bowie", Minute: 60}),
+							Away:      newCompetitor(teamB, 1, domain.MatchEvent{Name: "G.Harrison", Minute: 10}),
+						},
+					},
+				},
+				Participants: participants,
+			},
+			wantPrize: &domain.RankedPrize{
+				PrizeName: mostComebackWins,
+				Rankings: []domain.Rank{
+					{
+						Position:        1,
+						ImageURL:        "http://teamA.jpg",
+						ParticipantName: "Marc Pugh (Team A)",
+						Value:           "🔄 2 comebacks",
+					},
+					{
+						Position:        2,
+						ImageURL:        "http://teamB.jpg",
+						ParticipantName: "Steve Fletcher (Team B)",
+						Value:           "🔄 1 comeback",
+					},
+					// teamC and teamD do not rank
+				},
+			},
+		},
+		{
+			name: "wire-to-wire win must return default prize",
+			sweepstake: &domain.Sweepstake{
+				Tournament: &domain.Tournament{
+					Teams:   teams,
+					Matches: domain.MatchCollection{wireToWire},
+				},
+				Participants: participants,
+			},
+			wantPrize: defaultPrize,
+		},
+		{
+			name: "no matches must return default prize",
+			sweepstake: &domain.Sweepstake{
+				Tournament: &domain.Tournament{
+					Teams: teams,
+					// no matches
+				},
+				Participants: participants,
+			},
+			wantPrize: defaultPrize,
+		},
+		{
+			name:      "no sweepstake must return default prize",
+			wantPrize: defaultPrize,
+			// nil sweepstake
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			gotPrize := domain.MostComebackWins(tc.sweepstake)
+			cmpDiff(t, tc.wantPrize, gotPrize)
+		})
+	}
+}
+
+func TestQuickestHatTrick(t *testing.T) {
+	defaultPrize := &domain.RankedPrize{PrizeName: quickestHatTrick, Rankings: []domain.Rank{}}
+
+	teams := domain.TeamCollection{teamA, teamB, teamC, teamD}
+	participants := domain.ParticipantCollection{participantA, participantB, participantC, participantD}
+
+	// newCompetitor returns a competitor with a scorer event for each of the provided minutes, all attributed to the provided name
+	newCompetitor := func(team *domain.Team, name string, minutes ...uint8) domain.MatchCompetitor {
+		competitor := domain.MatchCompetitor{Team: team, Goals: uint8(len(minutes))}
+		for _, minute := range minutes {
+			competitor.Scorers = append(competitor.Scorers, domain.MatchEvent{Name: name, Minute: minute})
+		}
+		return competitor
+	}
+
+	newMatch := func(timestamp time.Time, home, away domain.MatchCompetitor) *domain.Match {
+		return &domain.Match{
+			Timestamp: timestamp,
+			Completed: true,
+			Home:      home,
+			Away:      away,
+		}
+	}
+
+	braces := newMatch(date1,
+		newCompetitor(teamD, "Bowie", 10, 20),
+		newCompetitor(teamC, "Mercury", 30),
+	)
+
+	tt := []struct {
+		name       string
+		sweepstake *domain.Sweepstake
+		wantPrize  *domain.RankedPrize
+	}{
+		{
+			name: "valid sweepstake must produce the expected rankings",
+			sweepstake: &domain.Sweepstake{
+				Tournament: &domain.Tournament{
+					Teams: teams,
+					Matches: domain.MatchCollection{
+						// slow hat-trick, four goals by the same player recorded out of order (ranked by third goal)
+						newMatch(date2,
+							newCompetitor(teamB, "G.Harrison", 10),
+							newCompetitor(teamC, "Mercury", 80, 30, 88, 50),
+						),
+						// quick hat-trick
+						newMatch(date1,
+							newCompetitor(teamA, "Lennon", 5, 12, 17),
+							newCompetitor(teamB, "G.Harrison", 45, 46),
+						),
+						// three goals by different players, not a hat-trick
+						{
+							Timestamp: date3,
+							Completed: true,
+							Home: domain.MatchCompetitor{
+								Team:  teamD,
+								Goals: 3,
+								Scorers: []domain.MatchEvent{
+									{Name: "Bowie", Minute: 1},
+									{Name: "Bowie", Minute: 2},
+									{Name: "Jagger", Minute: 3},
+								},
+							},
+							Away: newCompetitor(teamA, "Lennon", 90),
+						},
+						// three goals by unnamed players, not a hat-trick
+						newMatch(date3.Add(2*time.Hour),
+							newCompetitor(teamD, "", 1, 2, 3),
+							newCompetitor(teamB, ""),
+						),
+						// two goals in each of two matches by the same player, not a hat-trick
+						braces,
+						newMatch(date3.Add(4*time.Hour),
+							newCompetitor(teamC, "Mercury"),
+							newCompetitor(teamD, "Bowie", 1, 2),
+						),
+						// not completed, should be ignored
+						{
+							// completed is false
+							Timestamp: date3.Add(24 * time.Hour),
+							Home:      newCompetitor(teamD, "Bowie", 1, 2, 3),
+							Away:      newCompetitor(teamB, "G.Harrison"),
+						},
+					},
+				},
+				Participants: participants,
+			},
+			wantPrize: &domain.RankedPrize{
+				PrizeName: quickestHatTrick,
+				Rankings: []domain.Rank{
+					{
+						Position:        1,
+						ImageURL:        "http://teamA.jpg",
+						ParticipantName: "Marc Pugh (Team A)",
+						Value:           "🎩 17' Lennon (vs Team B 26/05)",
+					},
+					{
+						Position:        2,
+						ImageURL:        "http://teamC.jpg",
+						ParticipantName: "Brett Pitman (Team C)",
+						Value:           "🎩 80' Mercury (vs Team B 27/05)",
+					},
+					// teamB and teamD do not rank
+				},
+			},
+		},
+		{
+			name: "no hat-tricks must return default prize",
+			sweepstake: &domain.Sweepstake{
+				Tournament: &domain.Tournament{
+					Teams:   teams,
+					Matches: domain.MatchCollection{braces},
+				},
+				Participants: participants,
+			},
+			wantPrize: defaultPrize,
+		},
+		{
+			name: "no matches must return default prize",
+			sweepstake: &domain.Sweepstake{
+				Tournament: &domain.Tournament{
+					Teams: teams,
+					// no matches
+				},
+				Participants: participants,
+			},
+			wantPrize: defaultPrize,
+		},
+		{
+			name:      "no sweepstake must return default prize",
+			wantPrize: defaultPrize,
+			// nil sweepstake
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			gotPrize := domain.QuickestHatTrick(tc.sweepstake)
+			cmpDiff(t, tc.wantPrize, gotPrize)
+		})
+	}
+}
+
+func TestMostDifferentScorers(t *testing.T) {
+	defaultPrize := &domain.RankedPrize{PrizeName: mostDifferentScorers, Rankings: []domain.Rank{}}
+
+	teams := domain.TeamCollection{teamA, teamB, teamC, teamD}
+	participants := domain.ParticipantCollection{participantA, participantB, participantC, participantD}
+
+	// newCompetitor returns a competitor with a scorer event for each of the provided names
+	newCompetitor := func(team *domain.Team, names ...string) domain.MatchCompetitor {
+		competitor := domain.MatchCompetitor{Team: team, Goals: uint8(len(names))}
+		for idx, name := range names {
+			competitor.Scorers = append(competitor.Scorers, domain.MatchEvent{Name: name, Minute: uint8(10 * (idx + 1))})
+		}
+		return competitor
+	}
+
+	newMatch := func(home, away domain.MatchCompetitor) *domain.Match {
+		return &domain.Match{
+			Timestamp: date1,
+			Completed: true,
+			Home:      home,
+			Away:      away,
+		}
+	}
+
+	tt := []struct {
+		name       string
+		sweepstake *domain.Sweepstake
+		wantPrize  *domain.RankedPrize
+	}{
+		{
+			name: "valid sweepstake must produce the expected rankings",
+			sweepstake: &domain.Sweepstake{
+				Tournament: &domain.Tournament{
+					Teams: teams,
+					Matches: domain.MatchCollection{
+						// teamA = 2 (Lennon, McCartney), teamB = 1 (G.Harrison)
+						newMatch(
+							newCompetitor(teamA, "Lennon", "Lennon", "McCartney"),
+							newCompetitor(teamB, "G.Harrison"),
+						),
+						// teamA = 3 (+Starr, repeated Lennon), teamC = 1 (Mercury) with an own goal that does not count towards teamD
+						{
+							Timestamp: date2,
+							Completed: true,
+							Home:      newCompetitor(teamA, "Lennon", "Starr"),
+							Away: domain.MatchCompetitor{
+								Team:     teamC,
+								Goals:    1,
+								Scorers:  []domain.MatchEvent{{Name: "Mercury", Minute: 5}},
+								OwnGoals: []domain.MatchEvent{{Name: "May", Minute: 50}},
+							},
+						},
+						// teamB = 2 (+B.Epstein), unnamed scorer does not count
+						newMatch(
+							newCompetitor(teamB, "B.Epstein", ""),
+							newCompetitor(teamD),
+						),
+						// not completed, should be ignored
+						{
+							// completed is false
+							Timestamp: date3,
+							Home:      newCompetitor(teamD, "Bowie", "Jagger", "Richards", "Watts"),
+							Away:      newCompetitor(teamC, "Deacon", "Taylor"),
+						},
+					},
+				},
+				Participants: participants,
+			},
+			wantPrize: &domain.RankedPrize{
+				PrizeName: mostDifferentScorers,
+				Rankings: []domain.Rank{
+					{
+						Position:        1,
+						ImageURL:        "http://teamA.jpg",
+						ParticipantName: "Marc Pugh (Team A)",
+						Value:           "👥 3 scorers",
+					},
+					{
+						Position:        2,
+						ImageURL:        "http://teamB.jpg",
+						ParticipantName: "Steve Fletcher (Team B)",
+						Value:           "👥 2 scorers",
+					},
+					{
+						Position:        3,
+						ImageURL:        "http://teamC.jpg",
+						ParticipantName: "Brett Pitman (Team C)",
+						Value:           "👥 1 scorer",
+					},
+					// teamD do not rank
+				},
+			},
+		},
+		{
+			name: "no matches must return default prize",
+			sweepstake: &domain.Sweepstake{
+				Tournament: &domain.Tournament{
+					Teams: teams,
+					// no matches
+				},
+				Participants: participants,
+			},
+			wantPrize: defaultPrize,
+		},
+		{
+			name:      "no sweepstake must return default prize",
+			wantPrize: defaultPrize,
+			// nil sweepstake
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			gotPrize := domain.MostDifferentScorers(tc.sweepstake)
+			cmpDiff(t, tc.wantPrize, gotPrize)
+		})
+	}
+}
+
+func TestMostAssists(t *testing.T) {
+	defaultPrize := &domain.RankedPrize{PrizeName: mostAssists, Rankings: []domain.Rank{}}
+
+	teams := domain.TeamCollection{teamA, teamB, teamC, teamD}
+	participants := domain.ParticipantCollection{participantA, participantB, participantC, participantD}
+
+	tt := []struct {
+		name       string
+		sweepstake *domain.Sweepstake
+		wantPrize  *domain.RankedPrize
+	}{
+		{
+			name: "valid sweepstake must produce the expected rankings",
+			sweepstake: &domain.Sweepstake{
+				Tournament: &domain.Tournament{
+					Teams: teams,
+					Matches: domain.MatchCollection{
+						// teamA = 2, teamB = 1
+						{
+							Timestamp: date1,
+							Completed: true,
+							Home: domain.MatchCompetitor{
+								Team:  teamA,
+								Goals: 3,
+								Scorers: []domain.MatchEvent{
+									{Name: "Lennon", Assist: "McCartney", Minute: 10},
+									{Name: "McCartney", Assist: "Lennon", Minute: 20},
+									{Name: "Starr", Minute: 30}, // no assist
+								},
+							},
+							Away: domain.MatchCompetitor{
+								Team:    teamB,
+								Goals:   1,
+								Scorers: []domain.MatchEvent{{Name: "G.Harrison", Assist: "B.Epstein", Minute: 40}},
+							},
+						},
+						// teamC = 1, own goal assists do not count towards teamD
+						{
+							Timestamp: date2,
+							Completed: true,
+							Home: domain.MatchCompetitor{
+								Team:     teamC,
+								Goals:    1,
+								Scorers:  []domain.MatchEvent{{Name: "Mercury", Assist: "May", Minute: 5}},
+								OwnGoals: []domain.MatchEvent{{Name: "Deacon", Assist: "Taylor", Minute: 50}},
+							},
+							Away: domain.MatchCompetitor{Team: teamD, Goals: 1},
+						},
+						// not completed, should be ignored
+						{
+							// completed is false
+							Timestamp: date3,
+							Home: domain.MatchCompetitor{
+								Team:    teamD,
+								Goals:   3,
+								Scorers: []domain.MatchEvent{{Name: "Bowie", Assist: "Jagger", Minute: 1}, {Name: "Jagger", Assist: "Bowie", Minute: 2}, {Name: "Watts", Assist: "Richards", Minute: 3}},
+							},
+							Away: domain.MatchCompetitor{Team: teamC},
+						},
+					},
+				},
+				Participants: participants,
+			},
+			wantPrize: &domain.RankedPrize{
+				PrizeName: mostAssists,
+				Rankings: []domain.Rank{
+					{
+						Position:        1,
+						ImageURL:        "http://teamA.jpg",
+						ParticipantName: "Marc Pugh (Team A)",
+						Value:           "🅰️ 2 assists",
+					},
+					{
+						Position:        2,
+						ImageURL:        "http://teamB.jpg",
+						ParticipantName: "Steve Fletcher (Team B)",
+						Value:           "🅰️ 1 assist",
+					},
+					{
+						Position:        3,
+						ImageURL:        "http://teamC.jpg",
+						ParticipantName: "Brett Pitman (Team C)",
+						Value:           "🅰️ 1 assist",
+					},
+					// teamD do not rank
+				},
+			},
+		},
+		{
+			name: "matches without assist data must return default prize",
+			sweepstake: &domain.Sweepstake{
+				Tournament: &domain.Tournament{
+					Teams: teams,
+					Matches: domain.MatchCollection{
+						{
+							Timestamp: date1,
+							Completed: true,
+							Home:      domain.MatchCompetitor{Team: teamA, Goals: 1, Scorers: []domain.MatchEvent{{Name: "Lennon", Minute: 10}}},
+							Away:      domain.MatchCompetitor{Team: teamB},
+						},
+					},
+				},
+				Participants: participants,
+			},
+			wantPrize: defaultPrize,
+		},
+		{
+			name:      "no sweepstake must return default prize",
+			wantPrize: defaultPrize,
+			// nil sweepstake
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			gotPrize := domain.MostAssists(tc.sweepstake)
+			cmpDiff(t, tc.wantPrize, gotPrize)
+		})
+	}
+}
+
+func TestMostYellowCards(t *testing.T) {
+	defaultPrize := &domain.RankedPrize{PrizeName: mostYellowCards, Rankings: []domain.Rank{}}
+
+	teams := domain.TeamCollection{teamA, teamB, teamC, teamD}
+	participants := domain.ParticipantCollection{participantA, participantB, participantC, participantD}
+
+	tt := []struct {
+		name       string
+		sweepstake *domain.Sweepstake
+		wantPrize  *domain.RankedPrize
+	}{
+		{
+			name: "valid sweepstake must produce the expected rankings",
+			sweepstake: &domain.Sweepstake{
+				Tournament: &domain.Tournament{
+					Teams: teams,
+					Matches: domain.MatchCollection{
+						// teamA = 1 (1)
+						// teamB = 2 (2)
+						{
+							Completed: true,
+							Home: domain.MatchCompetitor{
+								Team:        teamA,
+								YellowCards: 1,
+							},
+							Away: domain.MatchCompetitor{
+								Team:        teamB,
+								YellowCards: 2,
+							},
+						},
+						// not completed, should be ignored
+						{
+							// completed is false
+							Home: domain.MatchCompetitor{
+								Team:        teamA,
+								YellowCards: 99,
+							},
+							Away: domain.MatchCompetitor{
+								Team:        teamB,
+								YellowCards: 99,
+							},
+						},
+						// teamB = 3 (5)
+						// teamC = 2 (2)
+						{
+							Completed: true,
+							Home: domain.MatchCompetitor{
+								Team:        teamB,
+								YellowCards: 3,
+							},
+							Away: domain.MatchCompetitor{
+								Team:        teamC,
+								YellowCards: 2,
+							},
+						},
+						// teamB = 1 (6)
+						// teamD = 0 (0)
+						{
+							Completed: true,
+							Home: domain.MatchCompetitor{
+								Team:        teamB,
+								YellowCards: 1,
+							},
+							Away: domain.MatchCompetitor{
+								Team:        teamD,
+								YellowCards: 0,
+							},
+						},
+					},
+				},
+				Participants: participants,
+			},
+			wantPrize: &domain.RankedPrize{
+				PrizeName: mostYellowCards,
+				Rankings: []domain.Rank{
+					{
+						Position:        1,
+						ImageURL:        "http://teamB.jpg",
+						ParticipantName: "Steve Fletcher (Team B)",
+						Value:           "\U0001F7E8️ 6",
+					},
+					{
+						Position:        2,
+						ImageURL:        "http://teamC.jpg",
+						ParticipantName: "Brett Pitman (Team C)",
+						Value:           "\U0001F7E8️ 2",
+					},
+					{
+						Position:        3,
+						ImageURL:        "http://teamA.jpg",
+						ParticipantName: "Marc Pugh (Team A)",
+						Value:           "\U0001F7E8️ 1",
+					},
+					// teamD do not rank
+				},
+			},
+		},
+		{
+			name: "minimum value of 2 must exclude teams with a value of 1",
+			sweepstake: &domain.Sweepstake{
+				Tournament: &domain.Tournament{
+					Teams: teams,
+					Matches: domain.MatchCollection{
+						// teamA = 1 (1)
+						// teamB = 2 (2)
+						{
+							Completed: true,
+							Home: domain.MatchCompetitor{
+								Team:        teamA,
+								YellowCards: 1,
+							},
+							Away: domain.MatchCompetitor{
+								Team:        teamB,
+								YellowCards: 2,
+							},
+						},
+						// not completed, should be ignored
+						{
+							// completed is false
+							Home: domain.MatchCompetitor{
+								Team:        teamA,
+								YellowCards: 99,
+							},
+							Away: domain.MatchCompetitor{
+								Team:        teamB,
+								YellowCards: 99,
+							},
+						},
+						// teamB = 3 (5)
+						// teamC = 2 (2)
+						{
+							Completed: true,
+							Home: domain.MatchCompetitor{
+								Team:        teamB,
+								YellowCards: 3,
+							},
+							Away: domain.MatchCompetitor{
+								Team:        teamC,
+								YellowCards: 2,
+							},
+						},
+						// teamB = 1 (6)
+						// teamD = 0 (0)
+						{
+							Completed: true,
+							Home: domain.MatchCompetitor{
+								Team:        teamB,
+								YellowCards: 1,
+							},
+							Away: domain.MatchCompetitor{
+								Team:        teamD,
+								YellowCards: 0,
+							},
+						},
+					},
+				},
+				Participants:  participants,
+				MinimumValues: map[string]int{"most_yellow_cards": 2},
+			},
+			wantPrize: &domain.RankedPrize{
+				PrizeName: mostYellowCards,
+				Rankings: []domain.Rank{
+					{
+						Position:        1,
+						ImageURL:        "http://teamB.jpg",
+						ParticipantName: "Steve Fletcher (Team B)",
+						Value:           "\U0001F7E8️ 6",
+					},
+					{
+						Position:        2,
+						ImageURL:        "http://teamC.jpg",
+						ParticipantName: "Brett Pitman (Team C)",
+						Value:           "\U0001F7E8️ 2",
+					},
+					// teamA and teamD do not rank
+				},
+			},
+		},
+		{
+			name: "no matches must return default prize",
+			sweepstake: &domain.Sweepstake{
+				Tournament: &domain.Tournament{
+					Teams: domain.TeamCollection{teamA, teamB},
+					// no matches
+				},
+				Participants: domain.ParticipantCollection{participantA, participantB},
+			},
+			wantPrize: defaultPrize,
+		},
+		{
+			name:      "no sweepstake must return default prize",
+			wantPrize: defaultPrize,
+			// nil sweepstake
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			gotPrize := domain.MostYellowCards(tc.sweepstake)
+			cmpDiff(t, tc.wantPrize, gotPrize)
+		})
+	}
+}
+
+func TestQuickestOwnGoal(t *testing.T) {
+	defaultPrize := &domain.RankedPrize{PrizeName: quickestOwnGoal, Rankings: []domain.Rank{}}
+
+	teams := domain.TeamCollection{teamA, teamB, teamC, teamD}
+	participants := domain.ParticipantCollection{participantA, participantB, participantC, participantD}
+
+	tt := []struct {
+		name       string
+		sweepstake *domain.Sweepstake
+		wantPrize  *domain.RankedPrize
+	}{
+		{
+			name: "valid sweepstake must produce the expected rankings",
+			sweepstake: &domain.Sweepstake{
+				Tournament: &domain.Tournament{
+					Teams: teams,
+					Matches: domain.MatchCollection{
+						{
+							Completed: true,
+							Timestamp: date1,
+							Home: domain.MatchCompetitor{
+								Team: teamA,
+								OwnGoals: []domain.MatchEvent{
+									{
+										Name:   "Lennon",
+										Minute: 90,
+										Offset: 1,
+									},
+									{
+										Name:   "McCartney",
+										Minute: 2,
+									},
+								},
+							},
+							Away: domain.MatchCompetitor{
+								Team: teamB,
+								OwnGoals: []domain.MatchEvent{
+									{
+										Name:   "G.Harrison",
+										Minute: 90,
+									},
+								},
+							},
+						},
+						// not completed, should be ignored
+						{
+							// completed is false
+							Timestamp: date2,
+							Home: domain.MatchCompetitor{
+								Team: teamA,
+								OwnGoals: []domain.MatchEvent{
+									{
+										Name:   "Starr",
+										Minute: 123,
+									},
+								},
+							},
+							Away: domain.MatchCompetitor{
+								Team: teamB,
+								OwnGoals: []domain.MatchEvent{
+									{
+										Name:   "B.Epstein",
+										Minute: 123,
+									},
+								},
+							},
+						}, {
+							Completed: true,
+							Timestamp: date3,
+							Home: domain.MatchCompetitor{
+								Team: teamC,
+								OwnGoals: []domain.MatchEvent{
+									{
+										Name:   "Johnny",
+										Minute: 46,
+									},
+									{
+										Name:   "Joey",
+										Minute: 45,
+									},
+								},
+							},
+							Away: domain.MatchCompetitor{
+								Team: teamD,
+								OwnGoals: []domain.MatchEvent{
+									{
+										Name:   "DeeDee",
+										Minute: 45,
+										Offset: 4,
+									},
+									{
+										Name:   "Tommy",
+										Minute: 45,
+										Offset: 5,
+									},
+								},
+							},
+						},
+					},
+				},
+				Participants: participants,
+			},
+			wantPrize: &domain.RankedPrize{
+				PrizeName: quickestOwnGoal,
+				Rankings: []domain.Rank{
+					{
+						Position:        1,
+						ImageURL:        "http://teamA.jpg",
+						ParticipantName: "Marc Pugh (Team A)",
+						Value:           "🙈 2' McCartney (vs Team B 26/05)",
+					},
+					{
+						Position:        2,
+						ImageURL:        "http://teamC.jpg",
+						ParticipantName: "Brett Pitman (Team C)",
+						Value:           "🙈 45' Joey (vs Team D 28/05)",
+					},
+					{
+						Position:        3,
+						ImageURL:        "http://teamD.jpg",
+						ParticipantName: "Shaun McDonald (Team D)",
+						Value:           "🙈 45'+4 DeeDee (vs Team C 28/05)",
+					},
+					{
+						Position:        4,
+						ImageURL:        "http://teamD.jpg",
+						ParticipantName: "Shaun McDonald (Team D)",
+						Value:           "🙈 45'+5 Tommy (vs Team C 28/05)",
+					},
+					{
+						Position:        5,
+						ImageURL:        "http://teamC.jpg",
+						ParticipantName: "Brett Pitman (Team C)",
+						Value:           "🙈 46' Johnny (vs Team D 28/05)",
+					},
+					{
+						Position:        6,
+						ImageURL:        "http://teamB.jpg",
+						ParticipantName: "Steve Fletcher (Team B)",
+						Value:           "🙈 90' G.Harrison (vs Team A 26/05)",
+					},
+					{
+						Position:        7,
+						ImageURL:        "http://teamA.jpg",
+						ParticipantName: "Marc Pugh (Team A)",
+						Value:           "🙈 90'+1 Lennon (vs Team B 26/05)",
+					},
+				},
+			},
+		},
+		{
+			name: "own goals at an identical minute must be ranked by match timestamp then by team name",
+			sweepstake: &domain.Sweepstake{
+				Tournament: &domain.Tournament{
+					Teams: teams,
+					Matches: domain.MatchCollection{
+						{
+							Completed: true,
+							Timestamp: date2,
+							Home: domain.MatchCompetitor{
+								Team:     teamD,
+								OwnGoals: []domain.MatchEvent{{Name: "Vedder", Minute: 45}},
+							},
+							Away: domain.MatchCompetitor{
+								Team:     teamC,
+								OwnGoals: []domain.MatchEvent{{Name: "Cornell", Minute: 45}},
+							},
+						},
+						{
+							Completed: true,
+							Timestamp: date1,
+							Home: domain.MatchCompetitor{
+								Team:     teamB,
+								OwnGoals: []domain.MatchEvent{{Name: "Cobain", Minute: 45}},
+							},
+							Away: domain.MatchCompetitor{
+								Team: teamA,
+							},
+						},
+					},
+				},
+				Participants: participants,
+			},
+			wantPrize: &domain.RankedPrize{
+				PrizeName: quickestOwnGoal,
+				Rankings: []domain.Rank{
+					{
+						Position:        1,
+						ImageURL:        "http://teamB.jpg",
+						ParticipantName: "Steve Fletcher (Team B)",
+						Value:           "🙈 45' Cobain (vs Team A 26/05)",
+					},
+					{
+						Position:        2,
+						ImageURL:        "http://teamC.jpg",
+						ParticipantName: "Brett Pitman (Team C)",
+						Value:           "🙈 45' Cornell (vs Team D 27/05)",
+					},
+					{
+						Position:        3,
+						ImageURL:        "http://teamD.jpg",
+						ParticipantName: "Shaun McDonald (Team D)",
+						Value:           "🙈 45' Vedder (vs Team C 27/05)",
+					},
+				},
+			},
+		},
+		{
+			name: "own goals outside the minute bounds must be excluded, including stopped time beyond the upper bound",
+			sweepstake: &domain.Sweepstake{
+				Tournament: &domain.Tournament{
+					Teams: teams,
+					Matches: domain.MatchCollection{
+						{
+							Completed: true,
+							Timestamp: date1,
+							Home: domain.MatchCompetitor{
+								Team: teamA,
+								OwnGoals: []domain.MatchEvent{
+									{Name: "Jagger", Minute: 4},               // before lower bound
+									{Name: "Richards", Minute: 90, Offset: 3}, // after upper bound
+								},
+							},
+							Away: domain.MatchCompetitor{
+								Team: teamB,
+								OwnGoals: []domain.MatchEvent{
+									{Name: "Watts", Minute: 5},  // exactly lower bound
+									{Name: "Wyman", Minute: 90}, // exactly upper bound
+								},
+							},
+						},
+						{
+							Completed: true,
+							Timestamp: date2,
+							Home: domain.MatchCompetitor{
+								Team:     teamC,
+								OwnGoals: []domain.MatchEvent{{Name: "Jones", Minute: 45, Offset: 2}}, // stopped time within bounds
+							},
+							Away: domain.MatchCompetitor{
+								Team: teamD,
+							},
+						},
+					},
+				},
+				Participants: participants,
+				MinuteBounds: map[string]domain.MinuteBounds{
+					"quickest_own_goal": {Min: 5, Max: 90},
+				},
+			},
+			wantPrize: &domain.RankedPrize{
+				PrizeName: quickestOwnGoal,
+				Rankings: []domain.Rank{
+					{
+						Position:        1,
+						ImageURL:        "http://teamB.jpg",
+						ParticipantName: "Steve Fletcher (Team B)",
+						Value:           "🙈 5' Watts (vs Team A 26/05)",
+					},
+					{
+						Position:        2,
+						ImageURL:        "http://teamC.jpg",
+						ParticipantName: "Brett Pitman (Team C)",
+						Value:           "🙈 45'+2 Jones (vs Team D 27/05)",
+					},
+					{
+						Position:        3,
+						ImageURL:        "http://teamB.jpg",
+						ParticipantName: "Steve Fletcher (Team B)",
+						Value:           "🙈 90' Wyman (vs Team A 26/05)",
+					},
+				},
+			},
+		},
+		{
+			name: "minute bounds of another prize must not exclude own goals",
+			sweepstake: &domain.Sweepstake{
+				Tournament: &domain.Tournament{
+					Teams: teams,
+					Matches: domain.MatchCollection{
+						{
+							Completed: true,
+							Timestamp: date1,
+							Home: domain.MatchCompetitor{
+								Team:     teamA,
+								OwnGoals: []domain.MatchEvent{{Name: "Richards", Minute: 90, Offset: 3}},
+							},
+							Away: domain.MatchCompetitor{
+								Team: teamB,
+							},
+						},
+					},
+				},
+				Participants: participants,
+				MinuteBounds: map[string]domain.MinuteBounds{
+					"quickest_red_card": {Max: 45},
+				},
+			},
+			wantPrize: &domain.RankedPrize{
+				PrizeName: quickestOwnGoal,
+				Rankings: []domain.Rank{
+					{
+						Position:        1,
+						ImageURL:        "http://teamA.jpg",
+						ParticipantName: "Marc Pugh (Team A)",
+						Value:           "🙈 90'+3 Richards (vs Team B 26/05)",
+					},
+				},
+			},
+		},
+		{
+			name: "team reassigned between matches must be ranked against the participant assigned at each match",
+			sweepstake: &domain.Sweepstake{
+				Tournament: &domain.Tournament{
+					Teams: teams,
+					Matches: domain.MatchCollection{
+						{
+							Completed: true,
+							Timestamp: date1,
+							Home: domain.MatchCompetitor{
+								Team:     teamA,
+								OwnGoals: []domain.MatchEvent{{Name: "Albarn", Minute: 12}},
+							},
+							Away: domain.MatchCompetitor{
+								Team: teamB,
+							},
+						},
+						{
+							Completed: true,
+							Timestamp: date3,
+							Home: domain.MatchCompetitor{
+								Team: teamC,
+							},
+							Away: domain.MatchCompetitor{
+								Team:     teamA,
+								OwnGoals: []domain.MatchEvent{{Name: "Coxon", Minute: 34}},
+							},
+						},
+					},
+				},
+				Participants: domain.ParticipantCollection{
+					participantA,
+					participantB,
+					participantC,
+					{
+						TeamID: "teamD",
+						Name:   "Shaun McDonald",
+						Assignments: []domain.TeamAssignment{
+							{TeamID: "teamA", From: date2},
+						},
+					},
+				},
+			},
+			wantPrize: &domain.RankedPrize{
+				PrizeName: quickestOwnGoal,
+				Rankings: []domain.Rank{
+					{
+						Position:        1,
+						ImageURL:        "http://teamA.jpg",
+						ParticipantName: "Marc Pugh (Team A)",
+						Value:           "🙈 12' Albarn (vs Team B 26/05)",
+					},
+					{
+						Position:        2,
+						ImageURL:        "http://teamA.jpg",
+						ParticipantName: "Shaun McDonald (Team A)",
+						Value:           "🙈 34' Coxon (vs Team C 28/05)",
+					},
+				},
+			},
+		},
+		{
+			name: "own goal dates must be rendered in the tournament location",
+			sweepstake: &domain.Sweepstake{
+				Tournament: &domain.Tournament{
+					Teams: teams,
+					Matches: domain.MatchCollection{
+						{
+							Completed: true,
+							Timestamp: time.Date(2018, 5, 26, 23, 30, 0, 0, time.UTC), // already 27/05 in Tokyo
+							Home: domain.MatchCompetitor{
+								Team:     teamA,
+								OwnGoals: []domain.MatchEvent{{Name: "Albarn", Minute: 12}},
+							},
+							Away: domain.MatchCompetitor{
+								Team: teamB,
+							},
+						},
+					},
+					Location: time.FixedZone("Asia/Tokyo", 9*3600),
+				},
+				Participants: participants,
+			},
+			wantPrize: &domain.RankedPrize{
+				PrizeName: quickestOwnGoal,
+				Rankings: []domain.Rank{
+					{
+						Position:        1,
+						ImageURL:        "http://teamA.jpg",
+						ParticipantName: "Marc Pugh (Team A)",
+						Value:           "🙈 12' Albarn (vs Team B 27/05)",
+					},
+				},
+			},
+		},
+		{
+			name: "custom value template must render the expected values with match context",
+			sweepstake: &domain.Sweepstake{
+				Tournament: &domain.Tournament{
+					Teams: teams,
+					Matches: domain.MatchCollection{
+						{
+							Completed: true,
+							Timestamp: date1,
+							Home: domain.MatchCompetitor{
+								Team:     teamA,
+								OwnGoals: []domain.MatchEvent{{Name: "Albarn", Minute: 90, Offset: 3}},
+							},
+							Away: domain.MatchCompetitor{
+								Team: teamB,
+							},
+						},
+					},
+					ValueTemplates: map[string]string{
+						"quickest_own_goal": "{{ .Event.Name }} ({{ .Team.Name }}) on {{ .Date }} against {{ .Against.Name }} at {{ .Value }}+{{ .Event.Offset }}",
+					},
+				},
+				Participants: participants,
+			},
+			wantPrize: &domain.RankedPrize{
+				PrizeName: quickestOwnGoal,
+				Rankings: []domain.Rank{
+					{
+						Position:        1,
+						ImageURL:        "http://teamA.jpg",
+						ParticipantName: "Marc Pugh (Team A)",
+						Value:           "Albarn (Team A) on 26/05 against Team B at 90+3",
+					},
+				},
+			},
+		},
+		{
+			name: "no matches must return default prize",
+			sweepstake: &domain.Sweepstake{
+				Tournament: &domain.Tournament{
+					Teams: domain.TeamCollection{teamA, teamB},
+					// no matches
+				},
+				Participants: domain.ParticipantCollection{participantA, participantB},
+			},
+			wantPrize: defaultPrize,
+		},
+		{
+			name:      "no sweepstake must return default prize",
+			wantPrize: defaultPrize,
+			// nil sweepstake
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			gotPrize := domain.QuickestOwnGoal(tc.sweepstake)
+			cmpDiff(t, tc.wantPrize, gotPrize)
+		})
+	}
+}
+
+func TestQuickestRedCard(t *testing.T) {
+	defaultPrize := &domain.RankedPrize{PrizeName: quickestRedCard, Rankings: []domain.Rank{}}
+
+	teams := domain.TeamCollection{teamA, teamB, teamC, teamD}
+	participants := domain.ParticipantCollection{participantA, participantB, participantC, participantD}
+
+	tt := []struct {
+		name       string
+		sweepstake *domain.Sweepstake
+		wantPrize  *domain.RankedPrize
+	}{
+		{
+			name: "valid sweepstake must produce the expected rankings",
+			sweepstake: &domain.Sweepstake{
+				Tournament: &domain.Tournament{
+					Teams: teams,
+					Matches: domain.MatchCollection{
+						{
+							Completed: true,
+							Timestamp: date1,
+							Home: domain.MatchCompetitor{
+								Team: teamA,
+								RedCards: []domain.MatchEvent{
+									{
+										Name:   "Lennon",
+										Minute: 90,
+										Offset: 1,
+									},
+									{
+										Name:   "McCartney",
+										Minute: 2,
+									},
+								},
+							},
+							Away: domain.MatchCompetitor{
+								Team: teamB,
+								RedCards: []domain.MatchEvent{
+									{
+										Name:   "G.Harrison",
+										Minute: 90,
+									},
+								},
+							},
+						},
+						// not completed, should be ignored
+						{
+							// completed is false
+							Timestamp: date2,
+							Home: domain.MatchCompetitor{
+								Team: teamA,
+								RedCards: []domain.MatchEvent{
+									{
+										Name:   "Starr",
+										Minute: 123,
+									},
+								},
+							},
+							Away: domain.MatchCompetitor{
+								Team: teamB,
+								RedCards: []domain.MatchEvent{
+									{
+										Name:   "B.Epstein",
+										Minute: 123,
+									},
+								},
+							},
+						}, {
+							Completed: true,
+							Timestamp: date3,
+							Home: domain.MatchCompetitor{
+								Team: teamC,
+								RedCards: []domain.MatchEvent{
+									{
+										Name:   "Johnny",
+										Minute: 46,
+									},
+									{
+										Name:   "Joey",
+										Minute: 45,
+									},
+								},
+							},
+							Away: domain.MatchCompetitor{
+								Team: teamD,
+								RedCards: []domain.MatchEvent{
+									{
+										Name:   "DeeDee",
+										Minute: 45,
+										Offset: 4,
+									},
+									{
+										Name:   "Tommy",
+										Minute: 45,
+										Offset: 5,
+									},
+								},
+							},
+						},
+					},
+				},
+				Participants: participants,
+			},
+			wantPrize: &domain.RankedPrize{
+				PrizeName: quickestRedCard,
+				Rankings: []domain.Rank{
+					{
+						Position:        1,
+						ImageURL:        "http://teamA.jpg",
+						ParticipantName: "Marc Pugh (Team A)",
+						Value:           "🟥 2' McCartney (vs Team B 26/05)",
+					},
+					{
+						Position:        2,
+						ImageURL:        "http://teamC.jpg",
+						ParticipantName: "Brett Pitman (Team C)",
+						Value:           "🟥 45' Joey (vs Team D 28/05)",
+					},
+					{
+						Position:        3,
+						ImageURL:        "http://teamD.jpg",
+						ParticipantName: "Shaun McDonald (Team D)",
+						Value:           "🟥 45'+4 DeeDee (vs Team C 28/05)",
+					},
+					{
+						Position:        4,
+						ImageURL:        "http://teamD.jpg",
+						ParticipantName: "Shaun McDonald (Team D)",
+						Value:           "🟥 45'+5 Tommy (vs Team C 28/05)",
+					},
+					{
+						Position:        5,
+						ImageURL:        "http://teamC.jpg",
+						ParticipantName: "Brett Pitman (Team C)",
+						Value:           "🟥 46' Johnny (vs Team D 28/05)",
+					},
+					{
+						Position:        6,
+						ImageURL:        "http://teamB.jpg",
+						ParticipantName: "Steve Fletcher (Team B)",
+						Value:           "🟥 90' G.Harrison (vs Team A 26/05)",
+					},
+					{
+						Position:        7,
+						ImageURL:        "http://teamA.jpg",
+						ParticipantName: "Marc Pugh (Team A)",
+						Value:           "🟥 90'+1 Lennon (vs Team B 26/05)",
+					},
+				},
+			},
+		},
+		{
+			name: "no matches must return default prize",
+			sweepstake: &domain.Sweepstake{
+				Tournament: &domain.Tournament{
+					Teams: domain.TeamCollection{teamA, teamB},
+					// no matches
+				},
+				Participants: domain.ParticipantCollection{participantA, participantB},
+			},
+			wantPrize: defaultPrize,
+		},
+		{
+			name:      "no sweepstake must return default prize",
+			wantPrize: defaultPrize,
+			// nil sweepstake
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			gotPrize := domain.QuickestRedCard(tc.sweepstake)
+			cmpDiff(t, tc.wantPrize, gotPrize)
+		})
+	}
+}
+
+func TestEntertainers(t *testing.T) {
+	defaultPrize := &domain.RankedPrize{PrizeName: entertainers, Rankings: []domain.Rank{}}
+
+	teams := domain.TeamCollection{teamA, teamB, teamC, teamD}
+	participants := domain.ParticipantCollection{participantA, participantB, participantC, participantD}
+
+	newMatch := func(home, away *domain.Team, homeGoals, awayGoals uint8) *domain.Match {
+		return &domain.Match{
+			Completed: true,
+			Home:      domain.MatchCompetitor{Team: home, Goals: homeGoals},
+			Away:      domain.MatchCompetitor{Team: away, Goals: awayGoals},
+		}
+	}
+
+	newMatches := func() domain.MatchCollection {
+
+		notCompleted := newMatch(teamA, teamB, 4, 0)
+		notCompleted.Completed = false
+
+		return domain.MatchCollection{
+			newMatch(teamA, teamB, 2, 2), // exactly 4 goals
+			newMatch(teamA, teamC, 2, 1), // 3 goals
+			newMatch(teamB, teamC, 3, 3), // 6 goals
+			newMatch(teamD, teamC, 1, 0), // 1 goal
+			notCompleted,                 // not completed, should be ignored
+		}
+	}
+
+	tt := []struct {
+		name       string
+		sweepstake *domain.Sweepstake
+		wantPrize  *domain.RankedPrize
+	}{
+		{
+			name: "matches with at least the default high scoring goals must produce the expected rankings",
+			sweepstake: &domain.Sweepstake{
+				Tournament: &domain.Tournament{
+					Teams:   teams,
+					Matches: newMatches(),
+				},
+				Participants: participants,
+			},
+			wantPrize: &domain.RankedPrize{
+				PrizeName: entertainers,
+				Rankings: []domain.Rank{
+					{
+						Position:        1,
+						ImageURL:        "http://teamB.jpg",
+						ParticipantName: "Steve Fletcher (Team B)",
+						Value:           "🍿 2 (4+ goal games)",
+					},
+					{
+						Position:        2,
+						ImageURL:        "http://teamA.jpg",
+						ParticipantName: "Marc Pugh (Team A)",
+						Value:           "🍿 1 (4+ goal games)",
+					},
+					{
+						Position:        3,
+						ImageURL:        "http://teamC.jpg",
+						ParticipantName: "Brett Pitman (Team C)",
+						Value:           "🍿 1 (4+ goal games)",
+					},
+					// teamD do not rank
+				},
+			},
+		},
+		{
+			name: "matches with at least the tournament's high scoring goals must produce the expected rankings",
+			sweepstake: &domain.Sweepstake{
+				Tournament: &domain.Tournament{
+					Teams:            teams,
+					Matches:          newMatches(),
+					HighScoringGoals: 6,
+				},
+				Participants: participants,
+			},
+			wantPrize: &domain.RankedPrize{
+				PrizeName: entertainers,
+				Rankings: []domain.Rank{
+					{
+						Position:        1,
+						ImageURL:        "http://teamB.jpg",
+						ParticipantName: "Steve Fletcher (Team B)",
+						Value:           "🍿 1 (6+ goal games)",
+					},
+					{
+						Position:        2,
+						ImageURL:        "http://teamC.jpg",
+						ParticipantName: "Brett Pitman (Team C)",
+						Value:           "🍿 1 (6+ goal games)",
+					},
+				},
+			},
+		},
+		{
+			name: "no high scoring matches must return default prize",
+			sweepstake: &domain.Sweepstake{
+				Tournament: &domain.Tournament{
+					Teams: teams,
+					Matches: domain.MatchCollection{
+						newMatch(teamA, teamB, 2, 1), // 3 goals
+					},
+				},
+				Participants: participants,
+			},
+			wantPrize: defaultPrize,
+		},
+		{
+			name:      "no sweepstake must return default prize",
+			wantPrize: defaultPrize,
+			// nil sweepstake
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			gotPrize := domain.Entertainers(tc.sweepstake)
+			cmpDiff(t, tc.wantPrize, gotPrize)
+		})
+	}
+}
+
+func TestMostConcededInMatch(t *testing.T) {
+	defaultPrize := &domain.RankedPrize{PrizeName: mostConcededInMatch, Rankings: []domain.Rank{}}
+
+	teams := domain.TeamCollection{teamA, teamB, teamC, teamD}
+	participants := domain.ParticipantCollection{participantA, participantB, participantC, participantD}
+
+	newMatch := func(ts time.Time, home, away *domain.Team, homeGoals, awayGoals uint8) *domain.Match {
+		return &domain.Match{
+			Completed: true,
+			Timestamp: ts,
+			Home:      domain.MatchCompetitor{Team: home, Goals: homeGoals},
+			Away:      domain.MatchCompetitor{Team: away, Goals: awayGoals},
+		}
+	}
+
+	notCompleted := newMatch(date3, teamD, teamC, 7, 0)
+	notCompleted.Completed = false
+
+	tt := []struct {
+		name       string
+		sweepstake *domain.Sweepstake
+		wantPrize  *domain.RankedPrize
+	}{
+		{
+			name: "valid sweepstake must produce the expected rankings by each team's worst match",
+			sweepstake: &domain.Sweepstake{
+				Tournament: &domain.Tournament{
+					Teams: teams,
+					Matches: domain.MatchCollection{
+						newMatch(date1, teamA, teamB, 1, 2), // teamA concede 2, teamB concede 1
+						newMatch(date2, teamC, teamA, 5, 0), // teamA concede 5, so its worst result is not its first match
+						newMatch(date3, teamB, teamD, 0, 2), // teamB concede 2, teamD concede 0
+						notCompleted,                        // not completed, should be ignored
+					},
+				},
+				Participants: participants,
+			},
+			wantPrize: &domain.RankedPrize{
+				PrizeName: mostConcededInMatch,
+				Rankings: []domain.Rank{
+					{
+						Position:        1,
+						ImageURL:        "http://teamA.jpg",
+						ParticipantName: "Marc Pugh (Team A)",
+						Value:           "😬 5 (vs Team C 27/05)",
+					},
+					{
+						Position:        2,
+						ImageURL:        "http://teamB.jpg",
+						ParticipantName: "Steve Fletcher (Team B)",
+						Value:           "😬 2 (vs Team D 28/05)",
+					},
+					// teamC and teamD have not conceded, so do not rank
+				},
+			},
+		},
+		{
+			name: "identical goals conceded must be ranked by earliest match",
+			sweepstake: &domain.Sweepstake{
+				Tournament: &domain.Tournament{
+					Teams: teams,
+					Matches: domain.MatchCollection{
+						newMatch(date2, teamA, teamB, 3, 0),
+						newMatch(date1, teamC, teamD, 3, 0),
+					},
+				},
+				Participants: participants,
+			},
+			wantPrize: &domain.RankedPrize{
+				PrizeName: mostConcededInMatch,
+				Rankings: []domain.Rank{
+					{
+						Position:        1,
+						ImageURL:        "http://teamD.jpg",
+						ParticipantName: "Shaun McDonald (Team D)",
+						Value:           "😬 3 (vs Team C 26/05)",
+					},
+					{
+						Position:        2,
+						ImageURL:        "http://teamB.jpg",
+						ParticipantName: "Steve Fletcher (Team B)",
+						Value:           "😬 3 (vs Team A 27/05)",
+					},
+				},
+			},
+		},
+		{
+			name: "no goals conceded must return default prize",
+			sweepstake: &domain.Sweepstake{
+				Tournament: &domain.Tournament{
+					Teams: teams,
+					Matches: domain.MatchCollection{
+						newMatch(date1, teamA, teamB, 0, 0),
+					},
+				},
+				Participants: participants,
+			},
+			wantPrize: defaultPrize,
+		},
+		{
+			name:      "no sweepstake must return default prize",
+			wantPrize: defaultPrize,
+			// nil sweepstake
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			gotPrize := domain.MostConcededInMatch(tc.sweepstake)
+			cmpDiff(t, tc.wantPrize, gotPrize)
+		})
+	}
+}
+
+func TestOverallStandings(t *testing.T) {
+	defaultPrize := &domain.RankedPrize{PrizeName: overallStandings, Rankings: []domain.Rank{}}
+
+	participants := domain.ParticipantCollection{participantA, participantB, participantC, participantD}
+
+	// newTournament returns a tournament whose final is won by teamA (if completed), and whose goals conceded are teamD = 3, teamB = 2, teamA = 1
+	newTournament := func(finalCompleted bool) *domain.Tournament {
+		return &domain.Tournament{
+			Teams: domain.TeamCollection{teamA, teamB, teamC, teamD},
+			Matches: domain.MatchCollection{
+				{
+					ID:        "A1",
+					Timestamp: date1,
+					Stage:     domain.GroupStage,
+					Completed: true,
+					Home:      domain.MatchCompetitor{Team: teamC, Goals: 3},
+					Away:      domain.MatchCompetitor{Team: teamD},
+					Winner:    teamC,
+				},
+				{
+					ID:        "F",
+					Timestamp: date2,
+					Stage:     domain.KnockoutStage,
+					Completed: finalCompleted,
+					Home:      domain.MatchCompetitor{Team: teamA, Goals: 2},
+					Away:      domain.MatchCompetitor{Team: teamB, Goals: 1},
+					Winner:    teamA,
+				},
+			},
+		}
+	}
+
+	tt := []struct {
+		name       string
+		sweepstake *domain.Sweepstake
+		wantPrize  *domain.RankedPrize
+	}{
+		{
+			name: "weighted prizes must produce the expected rankings",
+			sweepstake: &domain.Sweepstake{
+				Tournament:   newTournament(true),
+				Participants: participants,
+				PrizeWeightings: map[string][]int{
+					"winner":              {5},
+					"runner_up":           {2},
+					"most_goals_conceded": {3, 2, 1},
+				},
+			},
+			wantPrize: &domain.RankedPrize{
+				PrizeName: overallStandings,
+				Rankings: []domain.Rank{
+					{
+						Position:        1,
+						ImageURL:        teamA.ImageURL,
+						ParticipantName: "Marc Pugh (Team A)",
+						Value:           "🏅 6 pts", // winner (5) + 3rd most goals conceded (1)
+					},
+					{
+						Position:        2,
+						ImageURL:        teamB.ImageURL,
+						ParticipantName: "Steve Fletcher (Team B)",
+						Value:           "🏅 4 pts", // runner-up (2) + 2nd most goals conceded (2)
+					},
+					{
+						Position:        3,
+						ImageURL:        teamD.ImageURL,
+						ParticipantName: "Shaun McDonald (Team D)",
+						Value:           "🏅 3 pts", // 1st most goals conceded (3)
+					},
+					// teamC has no points
+				},
+			},
+		},
+		{
+			name: "identical totals must be ranked alphabetically",
+			sweepstake: &domain.Sweepstake{
+				Tournament:   newTournament(true),
+				Participants: participants,
+				PrizeWeightings: map[string][]int{
+					"runner_up":           {1},
+					"most_goals_conceded": {3, 2, 1},
+				},
+			},
+			wantPrize: &domain.RankedPrize{
+				PrizeName: overallStandings,
+				Rankings: []domain.Rank{
+					{
+						Position:        1,
+						ImageURL:        teamD.ImageURL,
+						ParticipantName: "Shaun McDonald (Team D)",
+						Value:           "🏅 3 pts",
+					},
+					{
+						Position:        2,
+						ImageURL:        teamB.ImageURL,
+						ParticipantName: "Steve Fletcher (Team B)",
+						Value:           "🏅 3 pts",
+					},
+					{
+						Position:        3,
+						ImageURL:        teamA.ImageURL,
+						ParticipantName: "Marc Pugh (Team A)",
+						Value:           "🏅 1 pt",
+					},
+				},
+			},
+		},
+		{
+			name: "unresolved outright prizes must not award points",
+			sweepstake: &domain.Sweepstake{
+				Tournament:   newTournament(false),
+				Participants: participants,
+				PrizeWeightings: map[string][]int{
+					"winner":              {5},
+					"most_goals_conceded": {3},
+				},
+			},
+			wantPrize: &domain.RankedPrize{
+				PrizeName: overallStandings,
+				Rankings: []domain.Rank{
+					{
+						Position:        1,
+						ImageURL:        teamD.ImageURL,
+						ParticipantName: "Shaun McDonald (Team D)",
+						Value:           "🏅 3 pts",
+					},
+				},
+			},
+		},
+		{
+			name: "no weightings must return default prize",
+			sweepstake: &domain.Sweepstake{
+				Tournament:   newTournament(true),
+				Participants: participants,
+			},
+			wantPrize: defaultPrize,
+		},
+		{
+			name:      "no sweepstake must return default prize",
+			wantPrize: defaultPrize,
+			// nil sweepstake
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			gotPrize := domain.OverallStandings(tc.sweepstake)
+			cmpDiff(t, tc.wantPrize, gotPrize)
+		})
+	}
+}
+
+// auditPrizes are the ranked prizes whose team-level audits are cached per tournament
+var auditPrizes = map[string]func(s *domain.Sweepstake) *domain.RankedPrize{
+	"most_goals_conceded":    domain.MostGoalsConceded,
+	"most_goals_knockouts":   domain.MostGoalsInKnockouts,
+	"longest_winning_streak": domain.LongestWinningStreak,
+	"most_comeback_wins":     domain.MostComebackWins,
+	"most_different_scorers": domain.MostDifferentScorers,
+	"most_assists":           domain.MostAssists,
+	"most_yellow_cards":      domain.MostYellowCards,
+	"entertainers":           domain.Entertainers,
+}
+
+func TestPrizeGenerators_MatchesExcludedFromPrizes(t *testing.T) {
+	// newMatches returns matches that contribute towards some of the prizes
+	newMatches := func() domain.MatchCollection {
+		return domain.MatchCollection{
+			{
+				ID:        "G1",
+				Timestamp: date1,
+				Stage:     domain.GroupStage,
+				Completed: true,
+				Home:      domain.MatchCompetitor{Team: teamA, Goals: 1, Scorers: []domain.MatchEvent{{Name: "Pugh", Minute: 10}}},
+				Away:      domain.MatchCompetitor{Team: teamB},
+				Winner:    teamA,
+			},
+			{
+				ID:        "R16_1",
+				Timestamp: date2,
+				Stage:     domain.KnockoutStage,
+				Completed: true,
+				Home:      domain.MatchCompetitor{Team: teamC, Goals: 2, Scorers: []domain.MatchEvent{{Name: "Pitman", Minute: 20}, {Name: "Fletcher", Minute: 30}}},
+				Away:      domain.MatchCompetitor{Team: teamD, Goals: 1, Scorers: []domain.MatchEvent{{Name: "McDonald", Minute: 5}}},
+				Winner:    teamC,
+			},
+		}
+	}
+
+	// newExcludedMatches returns matches that would change every prize if they were not excluded from prizes
+	newExcludedMatches := func(exclude bool) domain.MatchCollection {
+		return domain.MatchCollection{
+			{
+				ID:                "F",
+				Timestamp:         date1.Add(-time.Hour),
+				Stage:             domain.KnockoutStage,
+				Completed:         true,
+				ExcludeFromPrizes: exclude,
+				Home: domain.MatchCompetitor{
+					Team:        teamD,
+					Goals:       6,
+					YellowCards: 9,
+					Scorers: []domain.MatchEvent{
+						{Name: "Hero", Minute: 2},
+						{Name: "Hero", Minute: 3},
+						{Name: "Hero", Minute: 4},
+						{Name: "Sidekick", Assist: "Hero", Minute: 50},
+						{Name: "Understudy", Assist: "Hero", Minute: 55},
+					},
+				},
+				Away: domain.MatchCompetitor{
+					Team:     teamB,
+					Goals:    4,
+					OwnGoals: []domain.MatchEvent{{Name: "Unlucky", Minute: 85}},
+					RedCards: []domain.MatchEvent{{Name: "Villain", Minute: 1}},
+					Scorers: []domain.MatchEvent{
+						{Name: "Opener", Minute: 1},
+						{Name: "Opener", Minute: 60},
+						{Name: "Opener", Minute: 70},
+						{Name: "Opener", Minute: 80},
+					},
+				},
+				Winner: teamD,
+			},
+			{
+				ID:                "G2",
+				Timestamp:         date3,
+				Stage:             domain.GroupStage,
+				Completed:         true,
+				ExcludeFromPrizes: exclude,
+				Home:              domain.MatchCompetitor{Team: teamA},
+				Away:              domain.MatchCompetitor{Team: teamC},
+			},
+		}
+	}
+
+	newSweepstake := func(matches domain.MatchCollection) *domain.Sweepstake {
+		return &domain.Sweepstake{
+			Tournament: &domain.Tournament{
+				Teams:   domain.TeamCollection{teamA, teamB, teamC, teamD},
+				Matches: matches,
+			},
+			Participants: domain.ParticipantCollection{participantA, participantB, participantC, participantD},
+		}
+	}
+
+	outright := func(fn domain.OutrightPrizeGenerator) func(s *domain.Sweepstake) interface{} {
+		return func(s *domain.Sweepstake) interface{} { return fn(s) }
+	}
+	ranked := func(fn domain.RankedPrizeGenerator) func(s *domain.Sweepstake) interface{} {
+		return func(s *domain.Sweepstake) interface{} { return fn(s) }
+	}
+
+	generators := map[string]func(s *domain.Sweepstake) interface{}{
+		tournamentWinner:     outright(domain.TournamentWinner),
+		tournamentRunnerUp:   outright(domain.TournamentRunnerUp),
+		woodenSpoon:          outright(domain.WoodenSpoon),
+		firstEliminated:      outright(domain.FirstEliminated),
+		mostGoalsConceded:    ranked(domain.MostGoalsConceded),
+		mostGoalsInKnockouts: ranked(domain.MostGoalsInKnockouts),
+		goalRush:             ranked(domain.GoalRush),
+		longestWinningStreak: ranked(domain.LongestWinningStreak),
+		longestDefensiveRun:  ranked(domain.LongestDefensiveRun),
+		mostComebackWins:     ranked(domain.MostComebackWins),
+		quickestHatTrick:     ranked(domain.QuickestHatTrick),
+		mostDifferentScorers: ranked(domain.MostDifferentScorers),
+		mostAssists:          ranked(domain.MostAssists),
+		mostYellowCards:      ranked(domain.MostYellowCards),
+		quickestOwnGoal:      ranked(domain.QuickestOwnGoal),
+		quickestRedCard:      ranked(domain.QuickestRedCard),
+		entertainers:         ranked(domain.Entertainers),
+		mostConcededInMatch:  ranked(domain.MostConcededInMatch),
+	}
+
+	for name, prizeFn := range generators {
+		t.Run(name, func(t *testing.T) {
+			wantPrize := prizeFn(newSweepstake(newMatches()))
+
+			// matches are only meaningful if they would otherwise change the prize
+			if reflect.DeepEqual(wantPrize, prizeFn(newSweepstake(append(newMatches(), newExcludedMatches(false)...)))) {
+				t.Fatal("want matches that are not excluded from prizes to change the prize")
+			}
+
+			gotPrize := prizeFn(newSweepstake(append(newMatches(), newExcludedMatches(true)...)))
+			cmpDiff(t, wantPrize, gotPrize)
+		})
+	}
+}
+
+// newAuditPrizesTournament returns a tournament whose matches contribute towards each of the audit prizes
+func newAuditPrizesTournament(matchCount int) *domain.Tournament {
+	teams := domain.TeamCollection{teamA, teamB, teamC, teamD}
+	matches := make(domain.MatchCollection, 0)
+
+	for idx := 0; idx < matchCount; idx++ {
+		home, away := teams[idx%len(teams)], teams[(idx+1)%len(teams)]
+
+		stage := domain.GroupStage
+		if idx%3 == 0 {
+			stage = domain.KnockoutStage
+		}
+
+		matches = append(matches, &domain.Match{
+			ID:        fmt.Sprintf("M%d", idx),
+			Timestamp: date1.Add(time.Duration(idx) * time.Hour),
+			Stage:     stage,
+			Completed: true,
+			Home: domain.MatchCompetitor{
+				Team:        home,
+				Goals:       2,
+				YellowCards: uint8(idx % 4),
+				Scorers:     []domain.MatchEvent{{Name: fmt.Sprintf("Player%d", idx%5), Minute: 30}, {Name: "Captain", Minute: 80}},
+			},
+			Away: domain.MatchCompetitor{
+				Team:        away,
+				Goals:       1,
+				YellowCards: uint8(idx % 3),
+				Scorers:     []domain.MatchEvent{{Name: "Striker", Assist: "Winger", Minute: 10}},
+			},
+			Winner: home,
+		})
+	}
+
+	return &domain.Tournament{Teams: teams, Matches: matches}
+}
+
+func TestAuditPrizes_CachedAndUncachedMustAgree(t *testing.T) {
+	shared := newAuditPrizesTournament(24)
+
+	participants := []domain.ParticipantCollection{
+		{participantA, participantB, participantC, participantD},
+		{
+			{TeamID: "teamA", Name: "Jon Hartley"},
+			{TeamID: "teamB", Name: "Chris Lewis"},
+			{TeamID: "teamC", Name: "Roy Hodgson"},
+			{TeamID: "teamD", Name: "Harry Redknapp"},
+		},
+	}
+
+	for key, prizeFn := range auditPrizes {
+		t.Run(key, func(t *testing.T) {
+			for _, p := range participants {
+				// tournament is shared by each sweepstake, so its audits are cached after the first sweepstake
+				gotPrize := prizeFn(&domain.Sweepstake{Tournament: shared, Participants: p})
+
+				// tournament is new, so its audits are not cached
+				wantPrize := prizeFn(&domain.Sweepstake{Tournament: newAuditPrizesTournament(24), Participants: p})
+
+				cmpDiff(t, wantPrize, gotPrize)
+			}
+		})
+	}
+}
+
+func TestAuditPrizes_ConcurrentSweepstakesMustAgree(t *testing.T) {
+	shared := newAuditPrizesTournament(24)
+	participants := domain.ParticipantCollection{participantA, participantB, participantC, participantD}
+
+	for key, prizeFn := range auditPrizes {
+		t.Run(key, func(t *testing.T) {
+			wantPrize := prizeFn(&domain.Sweepstake{Tournament: newAuditPrizesTournament(24), Participants: participants})
+
+			gotPrizes := make([]*domain.RankedPrize, 8)
+			wg := &sync.WaitGroup{}
+			for idx := range gotPrizes {
+				wg.Add(1)
+				go func(idx int) {
+					defer wg.Done()
+					gotPrizes[idx] = prizeFn(&domain.Sweepstake{Tournament: shared, Participants: participants})
+				}(idx)
+			}
+			wg.Wait()
+
+			for _, gotPrize := range gotPrizes {
+				cmpDiff(t, wantPrize, gotPrize)
+			}
+		})
+	}
+}
+
+func TestTournament_InvalidatePrizeAudits(t *testing.T) {
+	participants := domain.ParticipantCollection{participantA, participantB, participantC, participantD}
+
+	for key, prizeFn := range auditPrizes {
+		t.Run(key, func(t *testing.T) {
+			tournament := newAuditPrizesTournament(24)
+			prizeFn(&domain.Sweepstake{Tournament: tournament, Participants: participants}) // caches audits of 24 matches
+
+			tournament.Matches = newAuditPrizesTournament(8).Matches
+			tournament.InvalidatePrizeAudits()
+
+			wantPrize := prizeFn(&domain.Sweepstake{Tournament: newAuditPrizesTournament(8), Participants: participants})
+			gotPrize := prizeFn(&domain.Sweepstake{Tournament: tournament, Participants: participants})
+			cmpDiff(t, wantPrize, gotPrize)
+		})
+	}
+}
+
+func BenchmarkAuditPrizes(b *testing.B) {
+	participants := domain.ParticipantCollection{participantA, participantB, participantC, participantD}
+
+	b.Run("shared tournament", func(b *testing.B) {
+		// audits are cached after the first iteration
+		tournament := newAuditPrizesTournament(64)
+		for i := 0; i < b.N; i++ {
+			for _, prizeFn := range auditPrizes {
+				prizeFn(&domain.Sweepstake{Tournament: tournament, Participants: participants})
+			}
+		}
+	})
+
+	b.Run("new tournament", func(b *testing.B) {
+		// audits are never cached
+		for i := 0; i < b.N; i++ {
+			b.StopTimer()
+			tournament := newAuditPrizesTournament(64)
+			b.StartTimer()
+
+			for _, prizeFn := range auditPrizes {
+				prizeFn(&domain.Sweepstake{Tournament: tournament, Participants: participants})
+			}
+		}
+	})
 }
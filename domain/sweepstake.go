@@ -3,6 +3,8 @@ package domain
 import (
 	"bytes"
 	"context"
+	"crypto/md5"
+	_ "embed"
 	"encoding/base64"
 	"encoding/json"
 	"errors"
@@ -11,20 +13,257 @@ import (
 	"io"
 	"io/fs"
 	"net/http"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
+
+	"github.com/santhosh-tekuri/jsonschema/v5"
 )
 
 type Sweepstake struct {
-	ID           string        `json:"id"`
-	Name         string        `json:"name"`
-	Headline     template.HTML `json:"headline"`
-	Tournament   *Tournament
-	Participants ParticipantCollection `json:"participants"`
-	Prizes       PrizeSettings         `json:"prizes"`
-	Branding     Branding              `json:"branding`
-	Build        bool                  `json:"build"`
+	ID                 string        `json:"id"`
+	Name               string        `json:"name"`
+	Headline           template.HTML `json:"headline"`
+	Description        string        `json:"description"`
+	DescriptionTrusted bool          `json:"description_trusted"`
+	Tournament         *Tournament
+	Participants       ParticipantCollection   `json:"participants"`
+	UnclaimedTeamIDs   []string                `json:"unclaimed_team_ids"`
+	Prizes             PrizeSettings           `json:"prizes"`
+	PrizeOrder         []string                `json:"prize_order"`
+	PrizeWeightings    map[string][]int        `json:"prize_weightings"`
+	MinuteBounds       map[string]MinuteBounds `json:"minute_bounds"`
+	MinimumValues      map[string]int          `json:"minimum_values"`
+	Branding           Branding                `json:"branding"`
+	Build              bool                    `json:"build"`
+	Lang               string                  `json:"lang"`
+	EnabledPrizes      []string                `json:"-"`
+	Translations       Translations            `json:"-"`
+
+	warnings    renderWarnings                            // problems encountered while rendering that did not prevent it, see RenderWarnings
+	localised   map[*template.Template]*template.Template // copy of each tournament template within the sweepstake's language, see localise
+	localisedMu sync.Mutex
+}
+
+// slugRx provides a regex pattern matcher that targets each run of characters that are not url-safe within a slug
+var slugRx = regexp.MustCompile(`[^a-z0-9]+`)
+
+// Slug returns a url-safe representation of the sweepstake id, for use as a path segment
+func (s *Sweepstake) Slug() string {
+	return toSlug(s.ID)
+}
+
+// ParticipantSlug returns a url-safe representation of the provided participant's name, for use as the filename of their page
+//
+// A participant without a name is represented by their team's name instead. If the representation is shared with another of
+// the sweepstake's participants, it is suffixed with the participant's team id so that each participant's slug is unique
+func (s *Sweepstake) ParticipantSlug(p *Participant) string {
+	if p == nil {
+		return ""
+	}
+
+	slug := s.participantBaseSlug(p)
+
+	for _, other := range s.Participants {
+		if other != nil && other != p && s.participantBaseSlug(other) == slug {
+			return toSlug(slug + "-" + p.TeamID)
+		}
+	}
+
+	return slug
+}
+
+// participantBaseSlug returns a url-safe representation of the provided participant's name, or of their team if they have no name
+func (s *Sweepstake) participantBaseSlug(p *Participant) string {
+	if slug := toSlug(p.Name); slug != "" {
+		return slug
+	}
+
+	if s.Tournament != nil {
+		if team := s.Tournament.Teams.GetByID(p.TeamID); team != nil {
+			if slug := toSlug(team.Name); slug != "" {
+				return slug
+			}
+		}
+	}
+
+	return toSlug(p.TeamID)
+}
+
+// toSlug returns a url-safe representation of the provided value
+func toSlug(value string) string {
+	slug := slugRx.ReplaceAllString(strings.ToLower(value), "-")
+	return strings.Trim(slug, "-")
+}
+
+// DescriptionHTML returns the sweepstake's description for rendering as an intro block
+//
+// The description is HTML-escaped, unless it is flagged as trusted in which case it is rendered as-is. Returns an empty value if there is no description
+func (s *Sweepstake) DescriptionHTML() template.HTML {
+	if s == nil {
+		return ""
+	}
+
+	if s.DescriptionTrusted {
+		return template.HTML(s.Description)
+	}
+
+	return template.HTML(template.HTMLEscapeString(s.Description))
+}
+
+// SummaryFor returns the summary of the participant who has picked the provided team alongside the team, according to the tournament's summary format
+//
+// A team that the sweepstake declares as unclaimed is summarised with the tournament's unclaimed label (default "Unclaimed") in place of a participant's name.
+// If the team has been reassigned (see Participant.Assignments), the participant who is currently assigned the team is summarised
+func (s *Sweepstake) SummaryFor(team *Team) string {
+	return s.summaryForAt(team, time.Time{})
+}
+
+// summaryForAt returns the summary of the participant who is assigned the provided team at the provided time alongside the team (see SummaryFor)
+//
+// A zero time represents the current time of the tournament
+func (s *Sweepstake) summaryForAt(team *Team, ts time.Time) string {
+	participant := s.participantForTeamAt(team.ID, ts)
+
+	if participant == nil && s.isUnclaimed(team.ID) {
+		label := s.Tournament.UnclaimedLabel
+		if label == "" {
+			label = defaultUnclaimedLabel
+		}
+		participant = &Participant{Name: label}
+	}
+
+	return getSummaryFromTeamAndParticipant(s.Tournament, team, participant)
+}
+
+// participantForTeamAt returns the participant who is assigned the team with the provided id at the provided time, or nil if there is none
+//
+// Each participant is assigned their TeamID from the outset, and the team of each of their assignments from its effective time,
+// so the latest assignment of the team that is effective at the provided time takes precedence. A zero time represents the current time of the tournament
+func (s *Sweepstake) participantForTeamAt(teamID string, ts time.Time) *Participant {
+	if !s.hasAssignments() {
+		return s.Participants.GetByTeamID(teamID)
+	}
+
+	if ts.IsZero() {
+		ts = s.Tournament.now()
+	}
+
+	var (
+		assigned *Participant
+		from     time.Time
+	)
+
+	for _, participant := range s.Participants {
+		if participant == nil {
+			continue
+		}
+
+		if participant.TeamID == teamID && assigned == nil {
+			assigned = participant
+		}
+
+		for _, assignment := range participant.Assignments {
+			if assignment.TeamID != teamID || assignment.From.After(ts) {
+				continue
+			}
+
+			if assigned == nil || assignment.From.After(from) {
+				assigned, from = participant, assignment.From
+			}
+		}
+	}
+
+	return assigned
+}
+
+// hasAssignments returns true if any of the sweepstake's participants has been reassigned a team
+func (s *Sweepstake) hasAssignments() bool {
+	for _, participant := range s.Participants {
+		if participant != nil && len(participant.Assignments) > 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isUnclaimed returns true if the sweepstake declares the team with the provided id as unclaimed
+func (s *Sweepstake) isUnclaimed(teamID string) bool {
+	for _, id := range s.UnclaimedTeamIDs {
+		if id == teamID {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParticipantNames returns the names of the sweepstake's participants in participant order, omitting any empty names
+//
+// Duplicate names are retained, since distinct participants may share a name
+func (s *Sweepstake) ParticipantNames() []string {
+	if s == nil {
+		return nil
+	}
+
+	var names []string
+	for _, participant := range s.Participants {
+		if participant == nil || strings.TrimSpace(participant.Name) == "" {
+			continue
+		}
+		names = append(names, participant.Name)
+	}
+
+	return names
+}
+
+// RenderWarnings returns each problem that has been encountered while rendering the sweepstake that did not prevent it from being rendered
+// (e.g. a label without a translation, or a value template that cannot be executed), in the order that they were first encountered
+//
+// Each distinct problem is only returned once, regardless of how many times the sweepstake has been rendered
+func (s *Sweepstake) RenderWarnings() []error {
+	if s == nil {
+		return nil
+	}
+
+	return s.warnings.list()
+}
+
+// renderWarnings collects the distinct problems that are encountered while rendering a sweepstake
+type renderWarnings struct {
+	mu   sync.Mutex
+	seen map[string]struct{}
+	errs []error
+}
+
+// add retains the provided problem, unless an identical problem has already been retained
+func (r *renderWarnings) add(err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.seen[err.Error()]; ok {
+		return
+	}
+
+	if r.seen == nil {
+		r.seen = make(map[string]struct{})
+	}
+
+	r.seen[err.Error()] = struct{}{}
+	r.errs = append(r.errs, err)
+}
+
+// list returns a copy of the retained problems
+func (r *renderWarnings) list() []error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]error(nil), r.errs...)
 }
 
 type Branding struct {
@@ -35,88 +274,701 @@ type Branding struct {
 	Tertiary         string `json:"tertiary_colour"`
 }
 
+// GenerateMarkup returns the sweepstake's markup rendered by the tournament's template
+//
+// An error is returned if the tournament was loaded without markup (see TournamentFSLoader.WithOptionalMarkup)
 func (s *Sweepstake) GenerateMarkup() ([]byte, error) {
 	// TODO: test this method using actual tournament data to check for regressions
+	if s.Tournament == nil {
+		return nil, fmt.Errorf("tournament: %w", ErrIsEmpty)
+	}
+
+	if s.Tournament.Template == nil {
+		return nil, fmt.Errorf("tournament '%s' has no markup: template: %w", s.Tournament.ID, ErrIsEmpty)
+	}
+
+	return s.generateLocalisedMarkup(s.Tournament.Template)
+}
+
+// GenerateMarkupVariant returns the sweepstake's markup rendered by the tournament's markup variant with the provided name (e.g. "mobile")
+//
+// If the tournament has no such variant, the markup is rendered by the tournament's template instead (see GenerateMarkup)
+func (s *Sweepstake) GenerateMarkupVariant(name string) ([]byte, error) {
+	if s.Tournament.HasMarkupVariant(name) {
+		return s.generateLocalisedMarkup(s.Tournament.Variants[name])
+	}
+
+	return s.GenerateMarkup()
+}
+
+// generateLocalisedMarkup returns the sweepstake's markup rendered by the provided tournament template, within the sweepstake's language
+func (s *Sweepstake) generateLocalisedMarkup(tpl *template.Template) ([]byte, error) {
+	localised, err := s.localise(tpl)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.GenerateMarkupWith(localised)
+}
+
+// GenerateMarkupWith returns the sweepstake's markup rendered by the provided template instead of the tournament's template
+//
+// The provided template receives the same data as the tournament's template, so that a new layout can be previewed against real data.
+// Any template funcs that the provided template uses must be registered by the caller, including the "t" func (e.g. as Sweepstake.Translate)
+func (s *Sweepstake) GenerateMarkupWith(tpl *template.Template) ([]byte, error) {
+	if tpl == nil {
+		return nil, fmt.Errorf("template: %w", ErrIsEmpty)
+	}
+
 	buf := &bytes.Buffer{}
 
+	// set title as sweepstake name, fallback to tournament name if missing
+	title := s.Name
+	if title == "" {
+		title = s.Tournament.Name
+	}
+
+	var lastUpdated string
+	if s.Tournament.WithLastUpdated {
+		lastUpdated = s.Tournament.now().Format("Mon 2 Jan 2006 at 15:04")
+	}
+
+	prizes := s.generatePrizes()
+
+	data := struct {
+		Title       string
+		ImageURL    string
+		LastUpdated string
+		Description template.HTML
+		Prizes      prizeData
+		PrizeOrder  []string
+		Sweepstake  *Sweepstake
+	}{
+		Title:       title,
+		ImageURL:    s.Tournament.ImageURL,
+		LastUpdated: lastUpdated,
+		Description: s.DescriptionHTML(),
+		Prizes:      prizes,
+		PrizeOrder:  prizes.order(s.PrizeOrder),
+		Sweepstake:  s,
+	}
+
+	if err := executeTemplate(tpl, buf, data); err != nil {
+		if field := prizes.disabledField(err); field != "" {
+			return nil, fmt.Errorf("cannot execute template: prize '%s' is disabled; guard with {{ with .Prizes.%s }}: %w", field, field, err)
+		}
+		return nil, fmt.Errorf("cannot execute template: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// GenerateParticipantMarkup returns the markup of the provided participant's page, rendered by the tournament's participant template
+//
+// The page is provided with the participant's team, the team's fixtures (matches that are not yet completed) and results (completed matches)
+// in tournament order, and the prizes that the participant is currently winning (the winner of an outright prize, or the top rank of a
+// ranked prize, whose team the participant was assigned when it won the prize). An error is returned if the tournament was loaded without
+// participant markup (see TournamentFSLoader.WithParticipantMarkupPath)
+func (s *Sweepstake) GenerateParticipantMarkup(p *Participant) ([]byte, error) {
+	if s.Tournament == nil {
+		return nil, fmt.Errorf("tournament: %w", ErrIsEmpty)
+	}
+
+	if s.Tournament.ParticipantTemplate == nil {
+		return nil, fmt.Errorf("tournament '%s' has no participant markup: template: %w", s.Tournament.ID, ErrIsEmpty)
+	}
+
+	if p == nil {
+		return nil, fmt.Errorf("participant: %w", ErrIsEmpty)
+	}
+
+	team := s.Tournament.Teams.GetByID(p.TeamID)
+	if team == nil {
+		return nil, fmt.Errorf("participant team id '%s': %w", p.TeamID, ErrNotFound)
+	}
+
+	var lastUpdated string
+	if s.Tournament.WithLastUpdated {
+		lastUpdated = s.Tournament.now().Format("Mon 2 Jan 2006 at 15:04")
+	}
+
+	var fixtures, results MatchCollection
+	for _, match := range s.Tournament.Matches.FilterByTeam(team) {
+		if match.Completed {
+			results = append(results, match)
+			continue
+		}
+		fixtures = append(fixtures, match)
+	}
+
+	// participant is winning each prize whose winning team they were assigned when it won the prize
+	summary := s.SummaryFor(team)
+	prizes := s.generatePrizes()
+
+	var winning []OutrightPrize
+	for _, prize := range prizes.outright() {
+		if s.isWonBy(prize, p) {
+			winning = append(winning, *prize)
+		}
+	}
+	for _, prize := range s.TopRankedAsOutright() {
+		if s.isWonBy(&prize, p) {
+			winning = append(winning, prize)
+		}
+	}
+
+	name := p.Name
+	if name == "" {
+		name = team.Name
+	}
+
+	data := struct {
+		Title       string
+		ImageURL    string
+		LastUpdated string
+		Summary     string
+		Participant *Participant
+		Team        *Team
+		Fixtures    MatchCollection
+		Results     MatchCollection
+		Winning     []OutrightPrize
+		Sweepstake  *Sweepstake
+	}{
+		Title:       name,
+		ImageURL:    team.ImageURL,
+		LastUpdated: lastUpdated,
+		Summary:     summary,
+		Participant: p,
+		Team:        team,
+		Fixtures:    fixtures,
+		Results:     results,
+		Winning:     winning,
+		Sweepstake:  s,
+	}
+
+	tpl, err := s.localise(s.Tournament.ParticipantTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	buf := &bytes.Buffer{}
+	if err := executeTemplate(tpl, buf, data); err != nil {
+		return nil, fmt.Errorf("cannot execute participant template: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// isWonBy returns true if the provided prize has been won by the provided participant, who must have been assigned the winning team
+// at the time that it won the prize (see participantForTeamAt)
+func (s *Sweepstake) isWonBy(prize *OutrightPrize, p *Participant) bool {
+	if !prize.IsResolved() || prize.team == nil {
+		return false
+	}
+
+	return s.participantForTeamAt(prize.team.ID, prize.at) == p
+}
+
+// Translate returns the translation of the provided (english) label within the sweepstake's language
+//
+// If the sweepstake's language is empty or "en", the label is returned as-is (default). A label without a translation
+// is also returned as-is, and is reported as a warning of the sweepstake (see Sweepstake.RenderWarnings)
+func (s *Sweepstake) Translate(label string) string {
+	translated, ok := s.Translations.Translate(s.Lang, label)
+	if !ok {
+		s.warnings.add(fmt.Errorf("lang '%s': label '%s': translation %w, falling back to %s", s.Lang, label, ErrNotFound, defaultLang))
+	}
+
+	return translated
+}
+
+// localise returns a copy of the provided tournament template whose "t" template func translates labels within the sweepstake's language
+//
+// The tournament's templates are shared by each of its sweepstakes and are never executed themselves, so each is copied once per sweepstake
+// before its first execution, and the copy is reused by each subsequent render
+func (s *Sweepstake) localise(tpl *template.Template) (*template.Template, error) {
+	s.localisedMu.Lock()
+	defer s.localisedMu.Unlock()
+
+	if localised, ok := s.localised[tpl]; ok {
+		return localised, nil
+	}
+
+	localised, err := tpl.Clone()
+	if err != nil {
+		return nil, fmt.Errorf("cannot clone template: %w", err)
+	}
+
+	if s.localised == nil {
+		s.localised = make(map[*template.Template]*template.Template)
+	}
+
+	s.localised[tpl] = localised.Funcs(template.FuncMap{"t": s.Translate})
+
+	return s.localised[tpl], nil
+}
+
+// executeTemplate executes the provided template, recovering from any panic that occurs during execution as an error
+func executeTemplate(tpl *template.Template, w io.Writer, data any) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+
+	return tpl.Execute(w, data)
+}
+
+// TopRankedAsOutright returns the top-ranked participant of each of the sweepstake's enabled ranked prizes as an outright prize, in display order
+//
+// This allows the headliner of every prize to be rendered uniformly. A ranked prize without any rankings yet has a participant name of "TBC"
+func (s *Sweepstake) TopRankedAsOutright() []OutrightPrize {
+	if s == nil || s.Tournament == nil {
+		return nil
+	}
+
+	var prizes []OutrightPrize
+
+	for _, ranked := range s.generatePrizes().ranked() {
+		prize := OutrightPrize{
+			PrizeName:       ranked.PrizeName,
+			ParticipantName: unresolvedParticipantName,
+		}
+
+		if len(ranked.Rankings) > 0 {
+			prize.ParticipantName = ranked.Rankings[0].ParticipantName
+			prize.ImageURL = ranked.Rankings[0].ImageURL
+			prize.team = ranked.Rankings[0].team
+			prize.at = ranked.Rankings[0].at
+		}
+
+		prizes = append(prizes, prize)
+	}
+
+	return prizes
+}
+
+// GeneratePrizeText returns a plain-text summary of each of the sweepstake's enabled prizes
+func (s *Sweepstake) GeneratePrizeText() (string, error) {
+	if s.Tournament == nil {
+		return "", fmt.Errorf("tournament: %w", ErrIsEmpty)
+	}
+
+	prizes := s.generatePrizes()
+	var sections []string
+
+	for _, prize := range prizes.outright() {
+		sections = append(sections, fmt.Sprintf("%s\n%s", prize.PrizeName, prize.ParticipantName))
+	}
+
+	for _, prize := range prizes.ranked() {
+		lines := []string{prize.PrizeName}
+		for _, rank := range prize.Rankings {
+			lines = append(lines, fmt.Sprintf("%d. %s %s", rank.Position, rank.ParticipantName, rank.Value))
+		}
+		if len(prize.Rankings) == 0 {
+			lines = append(lines, "None yet")
+		}
+		sections = append(sections, strings.Join(lines, "\n"))
+	}
+
+	return strings.Join(sections, "\n\n"), nil
+}
+
+// GeneratePrizeJSON returns a JSON representation of each of the sweepstake's enabled prizes, generated at the provided time
+//
+// Prizes are emitted in display order with a fixed field order. If now is zero, generated_at is omitted so that the output is
+// identical for identical input (default), otherwise it is stamped with the provided time. If pretty is true, the output is indented for readability
+func (s *Sweepstake) GeneratePrizeJSON(now time.Time, pretty bool) ([]byte, error) {
+	if s.Tournament == nil {
+		return nil, fmt.Errorf("tournament: %w", ErrIsEmpty)
+	}
+
+	prizes := s.generatePrizes()
+
+	data := struct {
+		SweepstakeID string           `json:"sweepstake_id"`
+		GeneratedAt  string           `json:"generated_at,omitempty"`
+		Outright     []*OutrightPrize `json:"outright"`
+		Ranked       []*RankedPrize   `json:"ranked"`
+	}{
+		SweepstakeID: s.ID,
+		Outright:     make([]*OutrightPrize, 0),
+		Ranked:       make([]*RankedPrize, 0),
+	}
+
+	if !now.IsZero() {
+		data.GeneratedAt = s.Tournament.inLocation(now).Format(time.RFC3339)
+	}
+
+	data.Outright = append(data.Outright, prizes.outright()...)
+	data.Ranked = append(data.Ranked, prizes.ranked()...)
+
+	if pretty {
+		return json.MarshalIndent(data, "", "  ")
+	}
+
+	return json.Marshal(data)
+}
+
+// prizeData represents the generated data for each of a sweepstake's prizes, which is nil if the prize is not enabled
+type prizeData struct {
+	Winner               *OutrightPrize
+	RunnerUp             *OutrightPrize
+	WoodenSpoon          *OutrightPrize
+	FirstEliminated      *OutrightPrize
+	MostGoalsConceded    *RankedPrize
+	MostGoalsInKnockouts *RankedPrize
+	GoalRush             *RankedPrize
+	LongestWinningStreak *RankedPrize
+	LongestDefensiveRun  *RankedPrize
+	MostComebackWins     *RankedPrize
+	QuickestHatTrick     *RankedPrize
+	MostDifferentScorers *RankedPrize
+	MostAssists          *RankedPrize
+	MostYellowCards      *RankedPrize
+	QuickestOwnGoal      *RankedPrize
+	QuickestRedCard      *RankedPrize
+	Entertainers         *RankedPrize
+	MostConcededInMatch  *RankedPrize
+	OverallStandings     *RankedPrize
+}
+
+// prizeKeys defines the key of each prize in default display order (outright prizes first), matching the fields of PrizeSettings
+var prizeKeys = []string{
+	"winner",
+	"runner_up",
+	"wooden_spoon",
+	"first_eliminated",
+	"most_goals_conceded",
+	"most_goals_knockouts",
+	"goal_rush",
+	"longest_winning_streak",
+	"longest_defensive_run",
+	"most_comeback_wins",
+	"quickest_hat_trick",
+	"most_different_scorers",
+	"most_assists",
+	"most_yellow_cards",
+	"quickest_own_goal",
+	"quickest_red_card",
+	"entertainers",
+	"most_conceded_single_match",
+	"overall_standings",
+}
+
+// Outright returns the enabled outright prize with the provided key, or nil if the key does not represent an enabled outright prize
+func (p prizeData) Outright(key string) *OutrightPrize {
+	switch key {
+	case "winner":
+		return p.Winner
+	case "runner_up":
+		return p.RunnerUp
+	case "wooden_spoon":
+		return p.WoodenSpoon
+	case "first_eliminated":
+		return p.FirstEliminated
+	default:
+		return nil
+	}
+}
+
+// Ranked returns the enabled ranked prize with the provided key, or nil if the key does not represent an enabled ranked prize
+func (p prizeData) Ranked(key string) *RankedPrize {
+	switch key {
+	case "most_goals_conceded":
+		return p.MostGoalsConceded
+	case "most_goals_knockouts":
+		return p.MostGoalsInKnockouts
+	case "goal_rush":
+		return p.GoalRush
+	case "longest_winning_streak":
+		return p.LongestWinningStreak
+	case "longest_defensive_run":
+		return p.LongestDefensiveRun
+	case "most_comeback_wins":
+		return p.MostComebackWins
+	case "quickest_hat_trick":
+		return p.QuickestHatTrick
+	case "most_different_scorers":
+		return p.MostDifferentScorers
+	case "most_assists":
+		return p.MostAssists
+	case "most_yellow_cards":
+		return p.MostYellowCards
+	case "quickest_own_goal":
+		return p.QuickestOwnGoal
+	case "quickest_red_card":
+		return p.QuickestRedCard
+	case "entertainers":
+		return p.Entertainers
+	case "most_conceded_single_match":
+		return p.MostConcededInMatch
+	case "overall_standings":
+		return p.OverallStandings
+	default:
+		return nil
+	}
+}
+
+// prizeFieldRx matches a reference to a prize field within a template execution error
+var prizeFieldRx = regexp.MustCompile(`\.Prizes\.(\w+)`)
+
+// disabledField returns the name of the disabled prize field referenced by the provided template execution error, or an empty string if there is none
+func (p prizeData) disabledField(err error) string {
+	for _, match := range prizeFieldRx.FindAllStringSubmatch(err.Error(), -1) {
+		field := reflect.ValueOf(p).FieldByName(match[1])
+		if field.IsValid() && field.Kind() == reflect.Pointer && field.IsNil() {
+			return match[1]
+		}
+	}
+
+	return ""
+}
+
+// order returns the keys of the enabled prizes, with those in the provided custom order first and any remaining prizes in default order
+func (p prizeData) order(custom []string) []string {
+	var keys []string
+	seen := make(map[string]struct{})
+
+	for _, key := range append(append([]string{}, custom...), prizeKeys...) {
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+
+		if p.Outright(key) != nil || p.Ranked(key) != nil {
+			keys = append(keys, key)
+		}
+	}
+
+	return keys
+}
+
+// outright returns the enabled outright prizes in display order
+func (p prizeData) outright() []*OutrightPrize {
+	var prizes []*OutrightPrize
+
+	for _, prize := range []*OutrightPrize{p.Winner, p.RunnerUp, p.WoodenSpoon, p.FirstEliminated} {
+		if prize != nil {
+			prizes = append(prizes, prize)
+		}
+	}
+
+	return prizes
+}
+
+// ranked returns the enabled ranked prizes in display order
+func (p prizeData) ranked() []*RankedPrize {
+	var prizes []*RankedPrize
+
+	for _, prize := range []*RankedPrize{p.MostGoalsConceded, p.MostGoalsInKnockouts, p.GoalRush, p.LongestWinningStreak, p.LongestDefensiveRun, p.MostComebackWins, p.QuickestHatTrick, p.MostDifferentScorers, p.MostAssists, p.MostYellowCards, p.QuickestOwnGoal, p.QuickestRedCard, p.Entertainers, p.MostConcededInMatch, p.OverallStandings} {
+		if prize != nil {
+			prizes = append(prizes, prize)
+		}
+	}
+
+	return prizes
+}
+
+// isPrizeEnabled returns true if the prize with the provided key is enabled by the provided setting, and is also permitted by the sweepstake's enabled prizes
+//
+// If the sweepstake has no enabled prizes, every prize is permitted
+func (s *Sweepstake) isPrizeEnabled(setting bool, key string) bool {
+	if !setting {
+		return false
+	}
+
+	if len(s.EnabledPrizes) == 0 {
+		return true
+	}
+
+	for _, enabled := range s.EnabledPrizes {
+		if enabled == key {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s *Sweepstake) generatePrizes() prizeData {
+	var data prizeData
+
 	// generate outright prize data
-	var winner, runnerUp *OutrightPrize
-	if s.Prizes.Winner {
-		winner = TournamentWinner(s)
+	if s.isPrizeEnabled(s.Prizes.Winner, "winner") {
+		data.Winner = TournamentWinner(s)
+	}
+	if s.isPrizeEnabled(s.Prizes.RunnerUp, "runner_up") {
+		data.RunnerUp = TournamentRunnerUp(s)
+	}
+	if s.isPrizeEnabled(s.Prizes.WoodenSpoon, "wooden_spoon") {
+		data.WoodenSpoon = WoodenSpoon(s)
+	}
+	if s.isPrizeEnabled(s.Prizes.FirstEliminated, "first_eliminated") {
+		data.FirstEliminated = FirstEliminated(s)
+	}
+
+	// generate ranked prize data
+	if s.isPrizeEnabled(s.Prizes.MostGoalsConceded, "most_goals_conceded") {
+		data.MostGoalsConceded = MostGoalsConceded(s)
+	}
+	if s.isPrizeEnabled(s.Prizes.MostGoalsInKnockouts, "most_goals_knockouts") {
+		data.MostGoalsInKnockouts = MostGoalsInKnockouts(s)
+	}
+	if s.isPrizeEnabled(s.Prizes.GoalRush, "goal_rush") {
+		data.GoalRush = GoalRush(s)
+	}
+	if s.isPrizeEnabled(s.Prizes.LongestWinningStreak, "longest_winning_streak") {
+		data.LongestWinningStreak = LongestWinningStreak(s)
+	}
+	if s.isPrizeEnabled(s.Prizes.LongestDefensiveRun, "longest_defensive_run") {
+		data.LongestDefensiveRun = LongestDefensiveRun(s)
+	}
+	if s.isPrizeEnabled(s.Prizes.MostComebackWins, "most_comeback_wins") {
+		data.MostComebackWins = MostComebackWins(s)
+	}
+	if s.isPrizeEnabled(s.Prizes.QuickestHatTrick, "quickest_hat_trick") {
+		data.QuickestHatTrick = QuickestHatTrick(s)
+	}
+	if s.isPrizeEnabled(s.Prizes.MostDifferentScorers, "most_different_scorers") {
+		data.MostDifferentScorers = MostDifferentScorers(s)
+	}
+	if s.isPrizeEnabled(s.Prizes.MostAssists, "most_assists") {
+		data.MostAssists = MostAssists(s)
 	}
-	if s.Prizes.RunnerUp {
-		runnerUp = TournamentRunnerUp(s)
+	if s.isPrizeEnabled(s.Prizes.MostYellowCards, "most_yellow_cards") {
+		data.MostYellowCards = MostYellowCards(s)
 	}
-
-	// generate ranked prize data
-	var mostGoalsConceded, mostYellowCards, quickestOwnGoal, quickestRedCard *RankedPrize
-	if s.Prizes.MostGoalsConceded {
-		mostGoalsConceded = MostGoalsConceded(s)
+	if s.isPrizeEnabled(s.Prizes.QuickestOwnGoal, "quickest_own_goal") {
+		data.QuickestOwnGoal = QuickestOwnGoal(s)
 	}
-	if s.Prizes.MostYellowCards {
-		mostYellowCards = MostYellowCards(s)
+	if s.isPrizeEnabled(s.Prizes.QuickestRedCard, "quickest_red_card") {
+		data.QuickestRedCard = QuickestRedCard(s)
 	}
-	if s.Prizes.QuickestOwnGoal {
-		quickestOwnGoal = QuickestOwnGoal(s)
+	if s.isPrizeEnabled(s.Prizes.Entertainers, "entertainers") {
+		data.Entertainers = Entertainers(s)
 	}
-	if s.Prizes.QuickestRedCard {
-		quickestRedCard = QuickestRedCard(s)
+	if s.isPrizeEnabled(s.Prizes.MostConcededInMatch, "most_conceded_single_match") {
+		data.MostConcededInMatch = MostConcededInMatch(s)
+	}
+	if s.isPrizeEnabled(s.Prizes.OverallStandings, "overall_standings") {
+		data.OverallStandings = OverallStandings(s)
 	}
 
-	// set title as sweepstake name, fallback to tournament name if missing
-	title := s.Name
-	if title == "" {
-		title = s.Tournament.Name
+	return data
+}
+
+type Participant struct {
+	TeamID      string           `json:"team_id"`
+	Name        string           `json:"participant_name"`
+	Email       string           `json:"email"`
+	Assignments []TeamAssignment `json:"assignments"`
+}
+
+// TeamAssignment represents a participant's assignment to a team from an effective time onwards (e.g. following a redraw)
+//
+// A participant is assigned their TeamID from the outset, so an assignment only needs to be listed once the participant's team changes
+type TeamAssignment struct {
+	TeamID string    `json:"team_id"`
+	From   time.Time `json:"from"`
+}
+
+// gravatarBaseURL defines the url that an email hash is appended to in order to obtain its gravatar
+const gravatarBaseURL = "https://www.gravatar.com/avatar/"
+
+// GravatarURL returns the url of the participant's gravatar, based on the md5 hash of their trimmed and lowercased email
+//
+// If the participant is nil or has no email, an empty string is returned
+func (p *Participant) GravatarURL() string {
+	if p == nil {
+		return ""
 	}
 
-	var lastUpdated string
-	if s.Tournament.WithLastUpdated {
-		lastUpdated = time.Now().Format("Mon 2 Jan 2006 at 15:04")
+	email := strings.ToLower(strings.TrimSpace(p.Email))
+	if email == "" {
+		return ""
 	}
 
-	type prizeData struct {
-		Winner            *OutrightPrize
-		RunnerUp          *OutrightPrize
-		MostGoalsConceded *RankedPrize
-		MostYellowCards   *RankedPrize
-		QuickestOwnGoal   *RankedPrize
-		QuickestRedCard   *RankedPrize
+	return fmt.Sprintf("%s%x", gravatarBaseURL, md5.Sum([]byte(email)))
+}
+
+// DisplayName returns a summary of the participant alongside the provided team according to the provided format (see Tournament.SummaryFormat),
+// e.g. "John Smith (Argentina)"
+//
+// If the format is empty, the default format is used. If the participant is nil or has no name, only the team name is returned
+func (p *Participant) DisplayName(team *Team, format string) string {
+	if p == nil || p.Name == "" {
+		return team.Name
 	}
 
-	data := struct {
-		Title       string
-		ImageURL    string
-		LastUpdated string
-		Prizes      prizeData
-		Sweepstake  *Sweepstake
-	}{
-		Title:       title,
-		ImageURL:    s.Tournament.ImageURL,
-		LastUpdated: lastUpdated,
-		Prizes: prizeData{
-			Winner:            winner,
-			RunnerUp:          runnerUp,
-			MostGoalsConceded: mostGoalsConceded,
-			MostYellowCards:   mostYellowCards,
-			QuickestOwnGoal:   quickestOwnGoal,
-			QuickestRedCard:   quickestRedCard,
-		},
-		Sweepstake: s,
-	}
-
-	if err := s.Tournament.Template.ExecuteTemplate(buf, "tpl", data); err != nil {
-		return nil, fmt.Errorf("cannot execute template: %w", err)
+	if format == "" {
+		format = defaultSummaryFormat
 	}
 
-	return buf.Bytes(), nil
+	return fmt.Sprintf(format, p.Name, team.Name)
 }
 
-type Participant struct {
-	TeamID string `json:"team_id"`
-	Name   string `json:"participant_name"`
+type ParticipantCollection []*Participant
+
+// Validate ensures that each participant references a known team, and that each of the provided teams is referenced by exactly one participant
+//
+// Team ids are matched exactly, so that participants can be validated independently of a sweepstake. Returns a MultiError if the collection is invalid
+func (pc ParticipantCollection) Validate(teams TeamCollection) error {
+	mErr := NewMultiError()
+	pc.validate(teams, nil, mErr)
+
+	if !mErr.IsEmpty() {
+		return mErr
+	}
+
+	return nil
 }
 
-type ParticipantCollection []*Participant
+// validate ensures that each participant references a known team, and that each of the provided teams is either referenced by exactly one participant
+// or is one of the provided unclaimed team ids
+func (pc ParticipantCollection) validate(teams TeamCollection, unclaimed []string, mErr MultiError) {
+	audit := &teamsAudit{teams: teams}
+	for idx, participant := range pc {
+		mErrIdx := mErr.WithPrefix(fmt.Sprintf("participant index %d", idx))
+
+		if participant == nil {
+			mErrIdx.Add(ErrIsEmpty)
+			continue
+		}
+
+		if ok := audit.ack(&Team{ID: participant.TeamID}); !ok {
+			mErrIdx.Add(fmt.Errorf("unrecognised participant team id: %s", participant.TeamID))
+		}
+	}
+
+	// teams that are intentionally unclaimed are accounted for without a participant
+	seen := make(map[string]struct{})
+	for _, id := range unclaimed {
+		mErrID := mErr.WithPrefix(fmt.Sprintf("unclaimed team id '%s'", id))
+
+		switch _, ok := seen[id]; {
+		case ok:
+			mErrID.Add(ErrIsDuplicate)
+		case pc.GetByTeamID(id) != nil:
+			mErrID.Add(errors.New("is claimed by a participant"))
+		case !audit.ack(&Team{ID: id}):
+			mErrID.Add(ErrNotFound)
+		}
+		seen[id] = struct{}{}
+	}
+
+	// summarise coverage before reporting the detailed count of each team
+	missing, multiple := audit.coverage()
+	if len(missing) > 0 {
+		mErr.Add(fmt.Errorf("%s no participant: %s", countTeams(len(missing)), strings.Join(missing, ", ")))
+	}
+	if len(multiple) > 0 {
+		mErr.Add(fmt.Errorf("%s multiple participants: %s", countTeams(len(multiple)), strings.Join(multiple, ", ")))
+	}
+
+	audit.validate(mErr, true)
+}
 
 func (pc ParticipantCollection) GetByTeamID(id string) *Participant {
 	for _, participant := range pc {
@@ -129,16 +981,101 @@ func (pc ParticipantCollection) GetByTeamID(id string) *Participant {
 }
 
 type PrizeSettings struct {
-	Winner            bool `json:"winner"`
-	RunnerUp          bool `json:"runner_up"`
-	MostGoalsConceded bool `json:"most_goals_conceded"`
-	MostYellowCards   bool `json:"most_yellow_cards"`
-	QuickestOwnGoal   bool `json:"quickest_own_goal"`
-	QuickestRedCard   bool `json:"quickest_red_card"`
+	Winner               bool `json:"winner"`
+	RunnerUp             bool `json:"runner_up"`
+	WoodenSpoon          bool `json:"wooden_spoon"`
+	FirstEliminated      bool `json:"first_eliminated"`
+	MostGoalsConceded    bool `json:"most_goals_conceded"`
+	MostGoalsInKnockouts bool `json:"most_goals_knockouts"`
+	GoalRush             bool `json:"goal_rush"`
+	LongestWinningStreak bool `json:"longest_winning_streak"`
+	LongestDefensiveRun  bool `json:"longest_defensive_run"`
+	MostComebackWins     bool `json:"most_comeback_wins"`
+	QuickestHatTrick     bool `json:"quickest_hat_trick"`
+	MostDifferentScorers bool `json:"most_different_scorers"`
+	MostAssists          bool `json:"most_assists"`
+	MostYellowCards      bool `json:"most_yellow_cards"`
+	QuickestOwnGoal      bool `json:"quickest_own_goal"`
+	QuickestRedCard      bool `json:"quickest_red_card"`
+	Entertainers         bool `json:"entertainers"`
+	MostConcededInMatch  bool `json:"most_conceded_single_match"`
+	OverallStandings     bool `json:"overall_standings"`
 }
 
 type SweepstakeCollection []*Sweepstake
 
+// DiffMarkup regenerates the markup of each sweepstake and returns the ids of those whose markup differs from the provided previous markup (keyed by id)
+//
+// A sweepstake without previous markup is considered to have changed
+func (sc SweepstakeCollection) DiffMarkup(prev map[string][]byte) (changed []string, err error) {
+	return sc.DiffEncodedMarkup(prev, nil)
+}
+
+// DiffEncodedMarkup behaves as DiffMarkup, but compares the previous markup with the regenerated markup once it has been encoded by
+// the provided func (e.g. in the form that it is written to disk)
+//
+// If encode is empty (nil), the regenerated markup is compared as-is
+func (sc SweepstakeCollection) DiffEncodedMarkup(prev map[string][]byte, encode func(b []byte) []byte) (changed []string, err error) {
+	for _, sweepstake := range sc {
+		markup, err := sweepstake.GenerateMarkup()
+		if err != nil {
+			return nil, fmt.Errorf("sweepstake '%s': %w", sweepstake.ID, err)
+		}
+
+		if encode != nil {
+			markup = encode(markup)
+		}
+
+		prevMarkup, ok := prev[sweepstake.ID]
+		if !ok || !bytes.Equal(prevMarkup, markup) {
+			changed = append(changed, sweepstake.ID)
+		}
+	}
+
+	return changed, nil
+}
+
+// IndexJSON returns a JSON index of each sweepstake that is flagged to be built, for consumption by a front-end
+//
+// The url of each sweepstake is its slug relative to the provided base url, so an empty base url produces root-relative urls
+func (sc SweepstakeCollection) IndexJSON(baseURL string) ([]byte, error) {
+	type indexEntry struct {
+		ID           string `json:"id"`
+		Name         string `json:"name"`
+		ImageURL     string `json:"image_url"`
+		TournamentID string `json:"tournament_id"`
+		URL          string `json:"url"`
+	}
+
+	data := struct {
+		Sweepstakes []indexEntry `json:"sweepstakes"`
+	}{
+		Sweepstakes: make([]indexEntry, 0),
+	}
+
+	baseURL = strings.TrimRight(baseURL, "/")
+
+	for _, sweepstake := range sc {
+		if !sweepstake.Build {
+			continue
+		}
+
+		if sweepstake.Tournament == nil {
+			return nil, fmt.Errorf("sweepstake '%s': tournament: %w", sweepstake.ID, ErrIsEmpty)
+		}
+
+		data.Sweepstakes = append(data.Sweepstakes, indexEntry{
+			ID:           sweepstake.ID,
+			Name:         sweepstake.Name,
+			ImageURL:     sweepstake.Tournament.ImageURL,
+			TournamentID: sweepstake.Tournament.ID,
+			URL:          fmt.Sprintf("%s/%s/", baseURL, sweepstake.Slug()),
+		})
+	}
+
+	return json.Marshal(data)
+}
+
 // BytesFunc returns a slice of bytes
 type BytesFunc func() ([]byte, error)
 
@@ -153,14 +1090,51 @@ type httpDoer interface {
 	Do(r *http.Request) (*http.Response, error)
 }
 
+// URLOption configures the request that is performed by BytesFromURL
+type URLOption func(o *urlOptions)
+
+type urlOptions struct {
+	maxBytes int64
+	header   http.Header
+}
+
+// MaxResponseBytes limits the size of the response body that BytesFromURL will accept to the provided number of bytes
+//
+// A value of 0 or less imposes no limit (default)
+func MaxResponseBytes(maxBytes int64) URLOption {
+	return func(o *urlOptions) {
+		o.maxBytes = maxBytes
+	}
+}
+
+// RequestHeaders sets the provided headers on the request that is performed by BytesFromURL (e.g. "X-Api-Key", "Accept")
+//
+// Each provided header replaces any existing value of the same key, so an "Authorization" header overrides the basic auth
+func RequestHeaders(header http.Header) URLOption {
+	return func(o *urlOptions) {
+		if o.header == nil {
+			o.header = http.Header{}
+		}
+
+		for key, values := range header {
+			o.header[http.CanonicalHeaderKey(key)] = append([]string(nil), values...)
+		}
+	}
+}
+
 // BytesFromURL parses the response body of a GET request to the provided url, using the provided basic auth (optional)
 //
 // If doer is empty (nil), the net/http package's default client is used
-func BytesFromURL(url string, basicAuth string, doer httpDoer) BytesFunc {
+func BytesFromURL(url string, basicAuth string, doer httpDoer, opts ...URLOption) BytesFunc {
 	if doer == nil {
 		doer = http.DefaultClient
 	}
 
+	options := &urlOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+
 	return func() ([]byte, error) {
 		req, err := http.NewRequest(http.MethodGet, url, nil)
 		if err != nil {
@@ -171,6 +1145,10 @@ func BytesFromURL(url string, basicAuth string, doer httpDoer) BytesFunc {
 			req.Header.Set("Authorization", "Basic "+base64.StdEncoding.EncodeToString([]byte(basicAuth)))
 		}
 
+		for key, values := range options.header {
+			req.Header[key] = values
+		}
+
 		resp, err := doer.Do(req)
 		if err != nil {
 			return nil, fmt.Errorf("cannot perform request: %w", err)
@@ -185,18 +1163,45 @@ func BytesFromURL(url string, basicAuth string, doer httpDoer) BytesFunc {
 			return nil, fmt.Errorf("invalid response content type: %s", contentType)
 		}
 
-		b, err := io.ReadAll(resp.Body)
+		var body io.Reader = resp.Body
+		if options.maxBytes > 0 {
+			body = io.LimitReader(resp.Body, options.maxBytes+1) // read one extra byte to detect an oversized body
+		}
+
+		b, err := io.ReadAll(body)
 		if err != nil {
 			return nil, fmt.Errorf("cannot read request body: %w", err)
 		}
 
+		if options.maxBytes > 0 && int64(len(b)) > options.maxBytes {
+			return nil, fmt.Errorf("response too large: exceeds %d bytes", options.maxBytes)
+		}
+
 		return b, nil
 	}
 }
 
+// BytesFromURLWithClient behaves as BytesFromURL, but performs the request using the provided http client
+//
+// This allows the caller full control over the client's configuration (e.g. proxy, tls, timeout).
+// If client is empty (nil), the net/http package's default client is used
+func BytesFromURLWithClient(url string, basicAuth string, client *http.Client, opts ...URLOption) BytesFunc {
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	return BytesFromURL(url, basicAuth, client, opts...)
+}
+
 type SweepstakesJSONLoader struct {
-	source      BytesFunc
-	tournaments TournamentCollection
+	source           BytesFunc
+	tournaments      TournamentCollection
+	schemaValidation bool
+	sanitiseNames    bool
+	enabledPrizes    []string
+	translations     Translations
+
+	validationOpts ValidationOptions
 }
 
 func (s *SweepstakesJSONLoader) WithSource(bytesFn BytesFunc) *SweepstakesJSONLoader {
@@ -209,6 +1214,50 @@ func (s *SweepstakesJSONLoader) WithTournamentCollection(tournaments TournamentC
 	return s
 }
 
+// WithSchemaValidation determines whether the source is validated against the sweepstakes json schema before it is unmarshalled
+//
+// If validate is true, every field that is missing or has the wrong type is reported by its path (e.g. sweepstakes[0].participants[3].team_id)
+func (s *SweepstakesJSONLoader) WithSchemaValidation(validate bool) *SweepstakesJSONLoader {
+	s.schemaValidation = validate
+	return s
+}
+
+// WithSanitisedNames determines whether invalid utf-8 is removed from each participant name, rather than failing validation
+//
+// If sanitise is false, a participant name that contains invalid utf-8 (e.g. from a mis-encoded source) fails validation (default)
+func (s *SweepstakesJSONLoader) WithSanitisedNames(sanitise bool) *SweepstakesJSONLoader {
+	s.sanitiseNames = sanitise
+	return s
+}
+
+// WithEnabledPrizes restricts the prizes that are generated for each sweepstake to those with the provided keys (e.g. to gate experimental prizes per deploy)
+//
+// If keys is empty, every prize that is enabled by a sweepstake's settings is generated (default).
+// Otherwise, a prize is only generated if it is enabled by a sweepstake's settings and its key is provided. Each key must represent a known prize
+func (s *SweepstakesJSONLoader) WithEnabledPrizes(keys []string) *SweepstakesJSONLoader {
+	s.enabledPrizes = nil
+	for _, key := range keys {
+		if key = strings.Trim(key, " "); key != "" {
+			s.enabledPrizes = append(s.enabledPrizes, key)
+		}
+	}
+	return s
+}
+
+// WithTranslations provides the translations of the labels that are rendered within each sweepstake's language (see TranslationsJSONLoader)
+//
+// If translations is nil, each sweepstake's language must be empty or "en" (default)
+func (s *SweepstakesJSONLoader) WithTranslations(translations Translations) *SweepstakesJSONLoader {
+	s.translations = translations
+	return s
+}
+
+// WithValidationOptions customises the messages of the errors that are returned when loading sweepstakes
+func (s *SweepstakesJSONLoader) WithValidationOptions(opts ValidationOptions) *SweepstakesJSONLoader {
+	s.validationOpts = opts
+	return s
+}
+
 func (s *SweepstakesJSONLoader) init() error {
 	if s.tournaments == nil {
 		return fmt.Errorf("tournaments: %w", ErrIsEmpty)
@@ -218,10 +1267,58 @@ func (s *SweepstakesJSONLoader) init() error {
 		return fmt.Errorf("source: %w", ErrIsEmpty)
 	}
 
+	mErr := NewMultiError()
+	validateEnabledPrizes(s.enabledPrizes, mErr)
+	if !mErr.IsEmpty() {
+		return mErr
+	}
+
 	return nil
 }
 
-func (s *SweepstakesJSONLoader) LoadSweepstakes(_ context.Context) (SweepstakeCollection, error) {
+func (s *SweepstakesJSONLoader) LoadSweepstakes(ctx context.Context) (SweepstakeCollection, error) {
+	sweepstakes, err := s.loadSweepstakes(ctx)
+	if err != nil {
+		return nil, s.validationOpts.apply(err)
+	}
+
+	return sweepstakes, nil
+}
+
+// SweepstakesReport summarises a collection of loaded sweepstakes, for diagnostic purposes
+type SweepstakesReport struct {
+	Sweepstakes  int // number of sweepstakes
+	Participants int // number of participants across all sweepstakes
+	Tournaments  int // number of distinct tournaments referenced by the sweepstakes
+}
+
+// LoadSweepstakesWithReport behaves as LoadSweepstakes, but also returns a report that summarises the loaded sweepstakes
+func (s *SweepstakesJSONLoader) LoadSweepstakesWithReport(ctx context.Context) (SweepstakeCollection, SweepstakesReport, error) {
+	sweepstakes, err := s.LoadSweepstakes(ctx)
+	if err != nil {
+		return nil, SweepstakesReport{}, err
+	}
+
+	return sweepstakes, newSweepstakesReport(sweepstakes), nil
+}
+
+func newSweepstakesReport(sweepstakes SweepstakeCollection) SweepstakesReport {
+	report := SweepstakesReport{Sweepstakes: len(sweepstakes)}
+	tournamentIDs := make(map[string]struct{})
+
+	for _, sweepstake := range sweepstakes {
+		report.Participants += len(sweepstake.Participants)
+
+		if sweepstake.Tournament != nil {
+			tournamentIDs[sweepstake.Tournament.ID] = struct{}{}
+		}
+	}
+	report.Tournaments = len(tournamentIDs)
+
+	return report
+}
+
+func (s *SweepstakesJSONLoader) loadSweepstakes(_ context.Context) (SweepstakeCollection, error) {
 	if err := s.init(); err != nil {
 		return nil, err
 	}
@@ -232,6 +1329,12 @@ func (s *SweepstakesJSONLoader) LoadSweepstakes(_ context.Context) (SweepstakeCo
 		return nil, err
 	}
 
+	if s.schemaValidation {
+		if err = validateSweepstakesSchema(raw); err != nil {
+			return nil, err
+		}
+	}
+
 	// parse as sweepstakes
 	var content = &struct {
 		Sweepstakes []struct {
@@ -258,28 +1361,127 @@ func (s *SweepstakesJSONLoader) LoadSweepstakes(_ context.Context) (SweepstakeCo
 			return nil, fmt.Errorf("sweepstake index %d: tournament id '%s': %w", idx, tournamentID, ErrNotFound)
 		}
 		sweepstake.Tournament = tournament
+		sweepstake.EnabledPrizes = s.enabledPrizes
+		sweepstake.Translations = s.translations
 
 		collection = append(collection, sweepstake)
 	}
 
-	return validateSweepstakes(collection)
+	return validateSweepstakes(collection, s.sanitiseNames)
+}
+
+//go:embed schema/sweepstakes.schema.json
+var sweepstakesSchemaJSON string
+
+// sweepstakesSchema describes the required fields and types of a sweepstakes source
+var sweepstakesSchema = jsonschema.MustCompileString("sweepstakes.schema.json", sweepstakesSchemaJSON)
+
+// validateSweepstakesSchema validates the provided raw sweepstakes against the sweepstakes json schema, reporting each violation by its path
+func validateSweepstakesSchema(raw []byte) error {
+	var instance interface{}
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+	if err := decoder.Decode(&instance); err != nil {
+		return fmt.Errorf("cannot unmarshal sweepstakes: %w", err)
+	}
+
+	var vErr *jsonschema.ValidationError
+	if err := sweepstakesSchema.Validate(instance); !errors.As(err, &vErr) {
+		return err
+	}
+
+	var errs []error
+	var collect func(ve *jsonschema.ValidationError)
+	collect = func(ve *jsonschema.ValidationError) {
+		for _, cause := range ve.Causes {
+			collect(cause)
+		}
+		if len(ve.Causes) == 0 {
+			errs = append(errs, newSchemaErrors(ve)...)
+		}
+	}
+	collect(vErr)
+
+	// guarantee error order, since the schema validates properties in no particular order
+	sort.SliceStable(errs, func(i, j int) bool {
+		return errs[i].Error() < errs[j].Error()
+	})
+
+	mErr := NewMultiError()
+	for _, err := range errs {
+		mErr.Add(err)
+	}
+
+	return mErr
+}
+
+// newSchemaErrors returns the errors represented by the provided schema violation, one per missing property if the violation is of a required keyword
+func newSchemaErrors(ve *jsonschema.ValidationError) []error {
+	path := schemaInstancePath(ve.InstanceLocation)
+
+	if !strings.HasSuffix(ve.KeywordLocation, "/required") {
+		if path == "" {
+			path = "sweepstakes source"
+		}
+		return []error{fmt.Errorf("%s: %s", path, ve.Message)}
+	}
+
+	// message lists each missing property in quotes (e.g. missing properties: 'id', 'name')
+	var errs []error
+	for _, name := range strings.Split(strings.TrimPrefix(ve.Message, "missing properties: "), ", ") {
+		name = strings.Trim(name, `'"`)
+		if path != "" {
+			name = path + "." + name
+		}
+		errs = append(errs, fmt.Errorf("%s: is required", name))
+	}
+
+	return errs
+}
+
+// schemaInstancePath returns the provided json pointer as a path of fields and indexes (e.g. /sweepstakes/0/id becomes sweepstakes[0].id)
+func schemaInstancePath(pointer string) string {
+	var path string
+
+	for _, token := range strings.Split(strings.TrimPrefix(pointer, "/"), "/") {
+		switch _, err := strconv.Atoi(token); {
+		case token == "":
+			continue
+		case err == nil:
+			path += "[" + token + "]"
+		case path == "":
+			path = token
+		default:
+			path += "." + token
+		}
+	}
+
+	return path
 }
 
-func validateSweepstakes(sweepstakes SweepstakeCollection) (SweepstakeCollection, error) {
+func validateSweepstakes(sweepstakes SweepstakeCollection, sanitiseNames bool) (SweepstakeCollection, error) {
 	ids := &sync.Map{}
+	slugs := &sync.Map{}
 	mErr := NewMultiError()
 
 	for _, sweepstake := range sweepstakes {
 		mErrIdx := mErr.WithPrefix(fmt.Sprintf("id '%s'", sweepstake.ID))
 
-		// check if this sweepstake id already exists in the collection
-		if _, ok := ids.Load(sweepstake.ID); ok {
+		if strings.Trim(sweepstake.ID, " ") != "" && sweepstake.Slug() == "" {
+			// an id without any url-safe characters would be written to the root of the site
+			mErrIdx.Add(fmt.Errorf("slug: %w", ErrIsEmpty))
+		} else if _, ok := ids.Load(sweepstake.ID); ok {
+			// this sweepstake id already exists in the collection
 			mErrIdx.Add(ErrIsDuplicate)
+		} else if _, ok := slugs.Load(sweepstake.Slug()); ok {
+			// distinct ids that produce the same slug would be written to the same path
+			mErrIdx.Add(fmt.Errorf("slug '%s': %w", sweepstake.Slug(), ErrIsDuplicate))
 		}
 		ids.Store(sweepstake.ID, struct{}{})
+		slugs.Store(sweepstake.Slug(), struct{}{})
 
 		// run remaining validation
-		validateSweepstake(sweepstake, mErr)
+		validateSweepstake(sweepstake, mErr, sanitiseNames)
 	}
 
 	if !mErr.IsEmpty() {
@@ -289,9 +1491,11 @@ func validateSweepstakes(sweepstakes SweepstakeCollection) (SweepstakeCollection
 	return sweepstakes, nil
 }
 
-func validateSweepstake(sweepstake *Sweepstake, mErr MultiError) *Sweepstake {
+func validateSweepstake(sweepstake *Sweepstake, mErr MultiError, sanitiseNames bool) *Sweepstake {
 	sweepstake.ID = strings.Trim(sweepstake.ID, " ")
 	sweepstake.Name = strings.Trim(sweepstake.Name, " ")
+	sweepstake.Description = strings.Trim(sweepstake.Description, " ")
+	sweepstake.Lang = strings.Trim(sweepstake.Lang, " ")
 
 	if sweepstake.ID == "" {
 		mErr.Add(fmt.Errorf("id: %w", ErrIsEmpty))
@@ -301,19 +1505,158 @@ func validateSweepstake(sweepstake *Sweepstake, mErr MultiError) *Sweepstake {
 		mErr.Add(fmt.Errorf("name: %w", ErrIsEmpty))
 	}
 
-	audit := &teamsAudit{teams: sweepstake.Tournament.Teams}
+	if !sweepstake.Translations.hasLang(sweepstake.Lang) {
+		mErr.Add(fmt.Errorf("lang '%s': %w", sweepstake.Lang, ErrNotFound))
+	}
+
 	for idx, participant := range sweepstake.Participants {
+		if participant == nil {
+			continue // reported when validating the collection
+		}
+
 		participant.TeamID = strings.Trim(participant.TeamID, " ")
 		participant.Name = strings.Trim(participant.Name, " ")
+		participant.Email = strings.Trim(participant.Email, " ")
 
-		mErrIdx := mErr.WithPrefix(fmt.Sprintf("participant index %d", idx))
+		if name, err := validateUTF8(participant.Name, sanitiseNames); err != nil {
+			mErr.WithPrefix(fmt.Sprintf("participant index %d", idx)).Add(fmt.Errorf("name '%s': %w", participant.Name, err))
+		} else {
+			participant.Name = name
+		}
 
-		if ok := audit.ack(&Team{ID: participant.TeamID}); !ok {
-			mErrIdx.Add(fmt.Errorf("unrecognised participant team id: %s", participant.TeamID))
+		// normalise to the canonical team id, so that participant lookups by team id succeed
+		if team := sweepstake.Tournament.getTeamByID(participant.TeamID); team != nil {
+			participant.TeamID = team.ID
 		}
 	}
 
-	audit.validate(mErr, true)
+	for idx, id := range sweepstake.UnclaimedTeamIDs {
+		id = strings.Trim(id, " ")
+		if team := sweepstake.Tournament.getTeamByID(id); team != nil {
+			id = team.ID
+		}
+		sweepstake.UnclaimedTeamIDs[idx] = id
+	}
+
+	sweepstake.Participants.validate(sweepstake.Tournament.Teams, sweepstake.UnclaimedTeamIDs, mErr)
+	validateTeamAssignments(sweepstake, mErr)
+
+	validateParticipantsInMatches(sweepstake, mErr)
+	validatePrizeOrder(sweepstake.PrizeOrder, mErr)
+	validatePrizeWeightings(sweepstake.PrizeWeightings, mErr)
+	validateMinuteBounds(sweepstake.MinuteBounds, mErr)
+	validateMinimumValues(sweepstake.MinimumValues, mErr)
 
 	return sweepstake
 }
+
+// validateTeamAssignments ensures that each participant's team assignments reference a known team from a provided time,
+// and that no team is assigned to more than one participant from the same time
+func validateTeamAssignments(sweepstake *Sweepstake, mErr MultiError) {
+	seen := make(map[string]struct{})
+
+	for idx, participant := range sweepstake.Participants {
+		if participant == nil {
+			continue // reported when validating the collection
+		}
+
+		for assignmentIdx := range participant.Assignments {
+			assignment := &participant.Assignments[assignmentIdx]
+			mErrIdx := mErr.WithPrefix(fmt.Sprintf("participant index %d: assignment index %d", idx, assignmentIdx))
+
+			// normalise to the canonical team id, so that assignments are matched by team id
+			assignment.TeamID = strings.Trim(assignment.TeamID, " ")
+			team := sweepstake.Tournament.getTeamByID(assignment.TeamID)
+			if team == nil {
+				mErrIdx.Add(fmt.Errorf("team id '%s': %w", assignment.TeamID, ErrNotFound))
+				continue
+			}
+			assignment.TeamID = team.ID
+
+			if assignment.From.IsZero() {
+				mErrIdx.Add(fmt.Errorf("from: %w", ErrIsEmpty))
+				continue
+			}
+
+			key := assignment.TeamID + "@" + assignment.From.Format(time.RFC3339)
+			if _, ok := seen[key]; ok {
+				mErrIdx.Add(fmt.Errorf("team id '%s' from %s: %w", assignment.TeamID, assignment.From.Format(time.RFC3339), ErrIsDuplicate))
+			}
+			seen[key] = struct{}{}
+		}
+	}
+}
+
+// validateParticipantsInMatches ensures that each team picked by a participant appears in at least one of the tournament's matches (e.g. a team that has withdrawn late)
+//
+// A tournament without any matches is skipped, since its fixtures are not yet known
+func validateParticipantsInMatches(sweepstake *Sweepstake, mErr MultiError) {
+	if len(sweepstake.Tournament.Matches) == 0 {
+		return
+	}
+
+	audit := &teamsAudit{teams: sweepstake.Tournament.Teams}
+	for _, match := range sweepstake.Tournament.Matches {
+		audit.ack(match.Home.Team)
+		audit.ack(match.Away.Team)
+	}
+
+	for idx, participant := range sweepstake.Participants {
+		if participant == nil {
+			continue
+		}
+
+		if count, ok := audit.get(&Team{ID: participant.TeamID}); ok && count == 0 {
+			mErr.WithPrefix(fmt.Sprintf("participant index %d", idx)).Add(fmt.Errorf("team id '%s': picked by participant but absent from matches", participant.TeamID))
+		}
+	}
+}
+
+// validatePrizeOrder ensures that each of the provided keys represents a known prize and appears only once
+func validatePrizeOrder(order []string, mErr MultiError) {
+	seen := make(map[string]struct{})
+
+	for _, key := range order {
+		var known bool
+		for _, prizeKey := range prizeKeys {
+			if key == prizeKey {
+				known = true
+				break
+			}
+		}
+
+		switch _, ok := seen[key]; {
+		case !known:
+			mErr.Add(fmt.Errorf("prize order key '%s': %w", key, ErrNotFound))
+		case ok:
+			mErr.Add(fmt.Errorf("prize order key '%s': %w", key, ErrIsDuplicate))
+		}
+		seen[key] = struct{}{}
+	}
+}
+
+// validateEnabledPrizes ensures that each of the provided keys represents a known prize
+func validateEnabledPrizes(keys []string, mErr MultiError) {
+	for _, key := range keys {
+		var known bool
+		for _, prizeKey := range prizeKeys {
+			if key == prizeKey {
+				known = true
+				break
+			}
+		}
+
+		if !known {
+			mErr.Add(fmt.Errorf("enabled prize key '%s': %w", key, ErrNotFound))
+		}
+	}
+}
+
+// countTeams returns a summary of the provided number of teams, for use as the subject of a sentence
+func countTeams(n int) string {
+	if n == 1 {
+		return "1 team has"
+	}
+
+	return fmt.Sprintf("%d teams have", n)
+}
@@ -14,9 +14,13 @@ import (
 	"os"
 	"path/filepath"
 	"reflect"
+	"strconv"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/sweepstake-markup-generator/domain"
 )
 
@@ -86,6 +90,7 @@ func TestBytesFromURL(t *testing.T) {
 		url       string
 		basicAuth string
 		doFunc    doFunc
+		opts      []domain.URLOption
 		wantBytes []byte
 		wantErr   error
 	}{
@@ -144,17 +149,193 @@ func TestBytesFromURL(t *testing.T) {
 			}),
 			wantErr: errors.New("cannot read request body: oops"),
 		},
+		{
+			name: "response body within max response bytes must return the expected bytes",
+			doFunc: doFunc(func(r *http.Request) (*http.Response, error) {
+				return okResponse(), nil
+			}),
+			opts:      []domain.URLOption{domain.MaxResponseBytes(11)},
+			wantBytes: []byte(`hello world`),
+		},
+		{
+			name: "response body larger than max response bytes must produce the expected error",
+			doFunc: doFunc(func(r *http.Request) (*http.Response, error) {
+				return okResponse(), nil
+			}),
+			opts:    []domain.URLOption{domain.MaxResponseBytes(10)},
+			wantErr: errors.New("response too large: exceeds 10 bytes"),
+		},
+		{
+			name: "zero max response bytes must not limit response body",
+			doFunc: doFunc(func(r *http.Request) (*http.Response, error) {
+				return okResponse(), nil
+			}),
+			opts:      []domain.URLOption{domain.MaxResponseBytes(0)},
+			wantBytes: []byte(`hello world`),
+		},
+		{
+			name:      "request headers must be applied to the request without clobbering basic auth",
+			basicAuth: "hello:world",
+			doFunc: doFunc(func(r *http.Request) (*http.Response, error) {
+				wantAuth := "Basic " + base64.StdEncoding.EncodeToString([]byte("hello:world"))
+				if gotAuth := r.Header.Get("Authorization"); gotAuth != wantAuth {
+					return nil, fmt.Errorf("want basic auth '%s', got '%s'", wantAuth, gotAuth)
+				}
+				if gotKey := r.Header.Get("X-Api-Key"); gotKey != "abc123" {
+					return nil, fmt.Errorf("want api key 'abc123', got '%s'", gotKey)
+				}
+				if gotAccept := r.Header.Values("Accept"); !reflect.DeepEqual(gotAccept, []string{"application/json", "text/plain"}) {
+					return nil, fmt.Errorf("want accept '%v', got '%v'", []string{"application/json", "text/plain"}, gotAccept)
+				}
+				return okResponse(), nil
+			}),
+			opts: []domain.URLOption{domain.RequestHeaders(http.Header{
+				"x-api-key": {"abc123"},
+				"Accept":    {"application/json", "text/plain"},
+			})},
+			wantBytes: []byte(`hello world`),
+		},
+		{
+			name:      "authorization request header must override basic auth",
+			basicAuth: "hello:world",
+			doFunc: doFunc(func(r *http.Request) (*http.Response, error) {
+				if gotAuth := r.Header.Values("Authorization"); !reflect.DeepEqual(gotAuth, []string{"Bearer token"}) {
+					return nil, fmt.Errorf("want authorization '[Bearer token]', got '%v'", gotAuth)
+				}
+				return okResponse(), nil
+			}),
+			opts:      []domain.URLOption{domain.RequestHeaders(http.Header{"Authorization": {"Bearer token"}})},
+			wantBytes: []byte(`hello world`),
+		},
 	}
 
 	for _, tc := range tt {
 		t.Run(tc.name, func(t *testing.T) {
-			gotBytes, gotErr := domain.BytesFromURL(tc.url, tc.basicAuth, tc.doFunc)()
+			gotBytes, gotErr := domain.BytesFromURL(tc.url, tc.basicAuth, tc.doFunc, tc.opts...)()
 			cmpError(t, tc.wantErr, gotErr)
 			cmpDiff(t, tc.wantBytes, gotBytes)
 		})
 	}
 }
 
+type roundTripFunc func(r *http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
+
+func TestBytesFromURLWithClient(t *testing.T) {
+	var gotRequests []*http.Request
+
+	client := &http.Client{
+		Transport: roundTripFunc(func(r *http.Request) (*http.Response, error) {
+			gotRequests = append(gotRequests, r)
+			return okResponse(), nil
+		}),
+	}
+
+	gotBytes, gotErr := domain.BytesFromURLWithClient("http://my-url", "hello:world", client)()
+	cmpError(t, nil, gotErr)
+	cmpDiff(t, []byte(`hello world`), gotBytes)
+
+	if len(gotRequests) != 1 {
+		t.Fatalf("want 1 request recorded by transport, got %d", len(gotRequests))
+	}
+
+	wantURL := "http://my-url"
+	if gotURL := gotRequests[0].URL.String(); gotURL != wantURL {
+		t.Fatalf("want url '%s', got '%s'", wantURL, gotURL)
+	}
+
+	wantAuth := "Basic " + base64.StdEncoding.EncodeToString([]byte("hello:world"))
+	if gotAuth := gotRequests[0].Header.Get("Authorization"); gotAuth != wantAuth {
+		t.Fatalf("want basic auth '%s', got '%s'", wantAuth, gotAuth)
+	}
+}
+
+func TestParticipant_DisplayName(t *testing.T) {
+	tt := []struct {
+		name            string
+		participant     *domain.Participant
+		team            *domain.Team
+		format          string
+		wantDisplayName string
+	}{
+		{
+			name:            "participant with name must return participant name and team name",
+			participant:     participantA,
+			team:            teamA,
+			wantDisplayName: "Marc Pugh (Team A)",
+		},
+		{
+			name:            "participant with name must return participant name and team name in the provided format",
+			participant:     participantA,
+			team:            teamA,
+			format:          "%[2]s — %[1]s",
+			wantDisplayName: "Team A — Marc Pugh",
+		},
+		{
+			name:            "participant without name must return team name only",
+			participant:     &domain.Participant{TeamID: "teamA"},
+			team:            teamA,
+			wantDisplayName: "Team A",
+		},
+		{
+			name:            "nil participant must return team name only",
+			team:            teamA,
+			format:          "%[2]s — %[1]s",
+			wantDisplayName: "Team A",
+			// participant is nil
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			gotDisplayName := tc.participant.DisplayName(tc.team, tc.format)
+			cmpDiff(t, tc.wantDisplayName, gotDisplayName)
+		})
+	}
+}
+
+func TestParticipant_GravatarURL(t *testing.T) {
+	tt := []struct {
+		name        string
+		participant *domain.Participant
+		wantURL     string
+	}{
+		{
+			name:        "participant with email must return url with md5 hash of email",
+			participant: &domain.Participant{Name: "John L", Email: "john@example.com"},
+			wantURL:     "https://www.gravatar.com/avatar/d4c74594d841139328695756648b6bd6",
+		},
+		{
+			name:        "participant with untrimmed mixed case email must return url with md5 hash of trimmed lowercase email",
+			participant: &domain.Participant{Name: "John L", Email: " John@Example.COM "},
+			wantURL:     "https://www.gravatar.com/avatar/d4c74594d841139328695756648b6bd6",
+		},
+		{
+			name:        "participant with empty email must return empty url",
+			participant: &domain.Participant{Name: "John L"},
+			// want empty url
+		},
+		{
+			name:        "participant with whitespace email must return empty url",
+			participant: &domain.Participant{Name: "John L", Email: "  "},
+			// want empty url
+		},
+		{
+			name: "nil participant must return empty url",
+			// nil participant
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			cmpDiff(t, tc.wantURL, tc.participant.GravatarURL())
+		})
+	}
+}
+
 func TestParticipantCollection_GetByTeamID(t *testing.T) {
 	participantA1 := &domain.Participant{
 		TeamID: "teamA",
@@ -204,51 +385,1590 @@ func TestParticipantCollection_GetByTeamID(t *testing.T) {
 	}
 }
 
-func TestSweepstakesJSONLoader_LoadSweepstakes(t *testing.T) {
-	testTourney1 := &domain.Tournament{
-		ID: "TestTourney1",
-		Teams: domain.TeamCollection{
-			{ID: "BPFC"},
-			{ID: "DTFC"},
-			{ID: "DYFC"},
-			{ID: "HUFC"},
-			{ID: "PTFC"},
-			{ID: "SJRFC"},
-			{ID: "STHFC"},
-			{ID: "WTFC"},
+func TestParticipantCollection_Validate(t *testing.T) {
+	teams := domain.TeamCollection{teamA, teamB}
+
+	tt := []struct {
+		name         string
+		participants domain.ParticipantCollection
+		wantErr      error
+	}{
+		{
+			name:         "participant for each team must be valid",
+			participants: domain.ParticipantCollection{participantB, participantA},
+		},
+		{
+			name:         "duplicate participants must produce the expected error",
+			participants: domain.ParticipantCollection{participantA, participantA},
+			wantErr: newMultiError([]string{
+				"1 team has no participant: teamB",
+				"1 team has multiple participants: teamA",
+				"team id 'teamA': count 2",
+				"team id 'teamB': count 0",
+			}),
+		},
+		{
+			name:         "participant with unknown team must produce the expected error",
+			participants: domain.ParticipantCollection{participantA, participantC},
+			wantErr: newMultiError([]string{
+				"participant index 1: unrecognised participant team id: teamC",
+				"1 team has no participant: teamB",
+				"team id 'teamB': count 0",
+			}),
+		},
+		{
+			name:         "participant with team id of different case must produce the expected error",
+			participants: domain.ParticipantCollection{participantA, {TeamID: "TEAMB", Name: "Steve Fletcher"}},
+			wantErr: newMultiError([]string{
+				"participant index 1: unrecognised participant team id: TEAMB",
+				"1 team has no participant: teamB",
+				"team id 'teamB': count 0",
+			}),
+		},
+		{
+			name:         "nil participant must produce the expected error",
+			participants: domain.ParticipantCollection{participantA, nil},
+			wantErr: newMultiError([]string{
+				"participant index 1: is empty",
+				"1 team has no participant: teamB",
+				"team id 'teamB': count 0",
+			}),
+		},
+		{
+			name: "empty collection must produce the expected error",
+			wantErr: newMultiError([]string{
+				"2 teams have no participant: teamA, teamB",
+				"team id 'teamA': count 0",
+				"team id 'teamB': count 0",
+			}),
 		},
 	}
 
-	testTourney2 := &domain.Tournament{
-		ID: "TestTourney2",
-		Teams: domain.TeamCollection{
-			{ID: "ABC"},
-			{ID: "DEF"},
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			gotErr := tc.participants.Validate(teams)
+			cmpError(t, tc.wantErr, gotErr)
+		})
+	}
+}
+func TestSweepstake_Slug(t *testing.T) {
+	tt := []struct {
+		name     string
+		id       string
+		wantSlug string
+	}{
+		{
+			name:     "url-safe id must be returned as-is",
+			id:       "example-euro2024",
+			wantSlug: "example-euro2024",
+		},
+		{
+			name:     "id with uppercase characters must be lowercased",
+			id:       "Example-EURO2024",
+			wantSlug: "example-euro2024",
+		},
+		{
+			name:     "id with runs of unsafe characters must have each run replaced by a single hyphen",
+			id:       "Example  Euro_2024 / Office!",
+			wantSlug: "example-euro-2024-office",
+		},
+		{
+			name:     "id with leading and trailing unsafe characters must have these trimmed",
+			id:       " ../example-euro2024? ",
+			wantSlug: "example-euro2024",
+		},
+		{
+			name: "empty id must return empty slug",
+			// id is empty
 		},
 	}
 
-	defaultTestTournaments := domain.TournamentCollection{
-		testTourney1,
-		testTourney2,
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			gotSlug := (&domain.Sweepstake{ID: tc.id}).Slug()
+			cmpDiff(t, tc.wantSlug, gotSlug)
+		})
+	}
+}
+
+func TestSweepstake_ParticipantSlug(t *testing.T) {
+	sweepstake := &domain.Sweepstake{
+		Tournament: &domain.Tournament{
+			Teams: domain.TeamCollection{teamA, teamB, teamC, teamD},
+		},
+		Participants: domain.ParticipantCollection{
+			{TeamID: "teamA", Name: "Marc Pugh"},
+			{TeamID: "teamB"},
+			{TeamID: "teamC", Name: "Steve Fletcher"},
+			{TeamID: "teamD", Name: "steve  fletcher!"},
+		},
 	}
 
 	tt := []struct {
-		name            string
-		tournaments     domain.TournamentCollection
-		configFilename  string
-		wantSweepstakes domain.SweepstakeCollection
-		wantErr         error
+		name        string
+		participant *domain.Participant
+		wantSlug    string
+	}{
+		{
+			name:        "participant with name must be represented by their name",
+			participant: sweepstake.Participants[0],
+			wantSlug:    "marc-pugh",
+		},
+		{
+			name:        "participant without name must be represented by their team name",
+			participant: sweepstake.Participants[1],
+			wantSlug:    "team-b",
+		},
+		{
+			name:        "participants with an identical representation must be suffixed by team id",
+			participant: sweepstake.Participants[2],
+			wantSlug:    "steve-fletcher-teamc",
+		},
+		{
+			name:        "participants with an identical representation must each have a unique slug",
+			participant: sweepstake.Participants[3],
+			wantSlug:    "steve-fletcher-teamd",
+		},
+		{
+			name: "nil participant must return empty slug",
+			// participant is nil
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			cmpDiff(t, tc.wantSlug, sweepstake.ParticipantSlug(tc.participant))
+		})
+	}
+}
+
+func TestSweepstake_ParticipantNames(t *testing.T) {
+	tt := []struct {
+		name         string
+		sweepstake   *domain.Sweepstake
+		wantEntrants []string
 	}{
 		{
-			name:           "valid sweepstake json must be loaded successfully",
+			name: "participant names must be returned in participant order",
+			sweepstake: &domain.Sweepstake{
+				Participants: domain.ParticipantCollection{participantB, participantA, participantC},
+			},
+			wantEntrants: []string{"Steve Fletcher", "Marc Pugh", "Brett Pitman"},
+		},
+		{
+			name: "empty participant names must be omitted",
+			sweepstake: &domain.Sweepstake{
+				Participants: domain.ParticipantCollection{
+					participantA,
+					{TeamID: "teamB"},
+					{TeamID: "teamC", Name: " "},
+					nil,
+					participantD,
+				},
+			},
+			wantEntrants: []string{"Marc Pugh", "Shaun McDonald"},
+		},
+		{
+			name: "duplicate participant names must be retained",
+			sweepstake: &domain.Sweepstake{
+				Participants: domain.ParticipantCollection{
+					participantA,
+					{TeamID: "teamB", Name: "Marc Pugh"},
+				},
+			},
+			wantEntrants: []string{"Marc Pugh", "Marc Pugh"},
+		},
+		{
+			name:       "no participants must return no names",
+			sweepstake: &domain.Sweepstake{},
+			// want no names
+		},
+		{
+			name: "no sweepstake must return no names",
+			// nil sweepstake
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			cmpDiff(t, tc.wantEntrants, tc.sweepstake.ParticipantNames())
+		})
+	}
+}
+
+func TestSweepstake_GenerateMarkup(t *testing.T) {
+	t.Run("tournament with no matches must render default prizes", func(t *testing.T) {
+		sweepstake := &domain.Sweepstake{
+			Name: "Test Sweepstake 1",
+			Tournament: &domain.Tournament{
+				Teams: domain.TeamCollection{teamA, teamB},
+				// no matches
+				Template: parseTemplate(t, `{{ .Title }}|`+
+					`{{ .Prizes.Winner.ParticipantName }}|`+
+					`{{ .Prizes.RunnerUp.ParticipantName }}|`+
+					`{{ range .Prizes.MostGoalsConceded.Rankings }}{{ .ParticipantName }}{{ else }}None yet{{ end }}|`+
+					`{{ range .Prizes.QuickestOwnGoal.Rankings }}{{ .ParticipantName }}{{ else }}None yet{{ end }}|`+
+					`{{ len .Sweepstake.Tournament.Matches }}`),
+			},
+			Participants: domain.ParticipantCollection{participantA, participantB},
+			Prizes: domain.PrizeSettings{
+				Winner:            true,
+				RunnerUp:          true,
+				MostGoalsConceded: true,
+				QuickestOwnGoal:   true,
+			},
+		}
+
+		gotMarkup, gotErr := sweepstake.GenerateMarkup()
+		cmpError(t, nil, gotErr)
+		cmpDiff(t, "Test Sweepstake 1|TBC|TBC|None yet|None yet|0", string(gotMarkup))
+	})
+
+	t.Run("tournament with clock must render last updated from clock in tournament location", func(t *testing.T) {
+		sweepstake := &domain.Sweepstake{
+			Name: "Test Sweepstake 1",
+			Tournament: &domain.Tournament{
+				Template:        parseTemplate(t, `{{ .Title }}|{{ .LastUpdated }}`),
+				WithLastUpdated: true,
+				Location:        mustLoadLocation(t, "Asia/Tokyo"),
+				Clock:           &fakeClock{Timestamp: time.Date(2018, 5, 26, 14, 0, 0, 0, time.UTC)},
+			},
+		}
+
+		gotMarkup, gotErr := sweepstake.GenerateMarkup()
+		cmpError(t, nil, gotErr)
+		cmpDiff(t, "Test Sweepstake 1|Sat 26 May 2018 at 23:00", string(gotMarkup))
+	})
+
+	t.Run("entrants template func must render participant names", func(t *testing.T) {
+		tournament, err := (&domain.TournamentFSLoader{}).
+			WithFileSystem(testdataFilesystem).
+			WithConfigPath(filepath.Join(testdataDir, tournamentsDir, tournamentConfigOkFilename)).
+			WithMarkupSource(func() ([]byte, error) {
+				return []byte(`{{ range entrants .Sweepstake }}{{ . }};{{ end }}`), nil
+			}).
+			WithTeamsLoader(newMockTeamsLoader(domain.TeamCollection{teamA, teamB}, nil)).
+			WithMatchesLoader(newMockMatchesLoader(domain.MatchCollection{
+				{
+					ID:   "A1",
+					Home: domain.MatchCompetitor{Team: teamA},
+					Away: domain.MatchCompetitor{Team: teamB},
+				},
+			}, nil)).
+			LoadTournament(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		sweepstake := &domain.Sweepstake{
+			Tournament:   tournament,
+			Participants: domain.ParticipantCollection{participantA, {TeamID: "teamB"}},
+		}
+
+		gotMarkup, gotErr := sweepstake.GenerateMarkup()
+		cmpError(t, nil, gotErr)
+		cmpDiff(t, "Marc Pugh;", string(gotMarkup))
+	})
+
+	t.Run("gravatar template func must render gravatar of participants with email", func(t *testing.T) {
+		tournament, err := (&domain.TournamentFSLoader{}).
+			WithFileSystem(testdataFilesystem).
+			WithConfigPath(filepath.Join(testdataDir, tournamentsDir, tournamentConfigOkFilename)).
+			WithMarkupSource(func() ([]byte, error) {
+				return []byte(`{{ range .Sweepstake.Participants }}{{ .Name }}:{{ with gravatar . }}<img src="{{ . }}" />{{ end }};{{ end }}`), nil
+			}).
+			WithTeamsLoader(newMockTeamsLoader(domain.TeamCollection{teamA, teamB}, nil)).
+			WithMatchesLoader(newMockMatchesLoader(domain.MatchCollection{
+				{
+					ID:   "A1",
+					Home: domain.MatchCompetitor{Team: teamA},
+					Away: domain.MatchCompetitor{Team: teamB},
+				},
+			}, nil)).
+			LoadTournament(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		sweepstake := &domain.Sweepstake{
+			Tournament: tournament,
+			Participants: domain.ParticipantCollection{
+				{TeamID: "teamA", Name: "Marc Pugh", Email: "john@example.com"},
+				participantB,
+			},
+		}
+
+		gotMarkup, gotErr := sweepstake.GenerateMarkup()
+		cmpError(t, nil, gotErr)
+		cmpDiff(t, `Marc Pugh:<img src="https://www.gravatar.com/avatar/d4c74594d841139328695756648b6bd6" />;Steve Fletcher:;`, string(gotMarkup))
+	})
+
+	t.Run("custom prize order must render enabled prizes in custom order followed by default order", func(t *testing.T) {
+		sweepstake := &domain.Sweepstake{
+			Name: "Test Sweepstake 1",
+			Tournament: &domain.Tournament{
+				Teams: domain.TeamCollection{teamA, teamB},
+				Template: parseTemplate(t, `{{ range .PrizeOrder }}`+
+					`{{ with $.Prizes.Outright . }}{{ .PrizeName }}|{{ end }}`+
+					`{{ with $.Prizes.Ranked . }}{{ .PrizeName }}|{{ end }}`+
+					`{{ end }}`),
+			},
+			Participants: domain.ParticipantCollection{participantA, participantB},
+			Prizes: domain.PrizeSettings{
+				Winner:            true,
+				RunnerUp:          true,
+				MostGoalsConceded: true,
+				QuickestOwnGoal:   true,
+			},
+			PrizeOrder: []string{"quickest_own_goal", "most_yellow_cards", "runner_up"},
+		}
+
+		gotMarkup, gotErr := sweepstake.GenerateMarkup()
+		cmpError(t, nil, gotErr)
+		cmpDiff(t, "Quickest Own Goal|Tournament Runner-Up|Tournament Winner|Most Goals Conceded|", string(gotMarkup))
+	})
+
+	t.Run("no prize order must render enabled prizes in default order", func(t *testing.T) {
+		sweepstake := &domain.Sweepstake{
+			Name: "Test Sweepstake 1",
+			Tournament: &domain.Tournament{
+				Teams:    domain.TeamCollection{teamA, teamB},
+				Template: parseTemplate(t, `{{ range .PrizeOrder }}{{ . }}|{{ end }}`),
+			},
+			Participants: domain.ParticipantCollection{participantA, participantB},
+			Prizes: domain.PrizeSettings{
+				QuickestOwnGoal: true,
+				Winner:          true,
+				WoodenSpoon:     true,
+			},
+		}
+
+		gotMarkup, gotErr := sweepstake.GenerateMarkup()
+		cmpError(t, nil, gotErr)
+		cmpDiff(t, "winner|wooden_spoon|quickest_own_goal|", string(gotMarkup))
+	})
+
+	t.Run("tournament without last updated must not render last updated", func(t *testing.T) {
+		sweepstake := &domain.Sweepstake{
+			Name: "Test Sweepstake 1",
+			Tournament: &domain.Tournament{
+				Template: parseTemplate(t, `{{ .Title }}|{{ .LastUpdated }}`),
+				Clock:    &fakeClock{Timestamp: time.Date(2018, 5, 26, 14, 0, 0, 0, time.UTC)},
+			},
+		}
+
+		gotMarkup, gotErr := sweepstake.GenerateMarkup()
+		cmpError(t, nil, gotErr)
+		cmpDiff(t, "Test Sweepstake 1|", string(gotMarkup))
+	})
+}
+
+func TestSweepstake_GenerateMarkupWith(t *testing.T) {
+	sweepstake := &domain.Sweepstake{
+		Name: "Test Sweepstake 1",
+		Tournament: &domain.Tournament{
+			Name:     "Test Tournament 1",
+			Teams:    domain.TeamCollection{teamA, teamB},
+			Template: parseTemplate(t, `<h1>Original</h1>`),
+		},
+		Participants: domain.ParticipantCollection{participantA, participantB},
+		Prizes:       domain.PrizeSettings{Winner: true},
+	}
+
+	t.Run("override template must be rendered instead of tournament template", func(t *testing.T) {
+		tpl := parseTemplate(t, `<h1>Override</h1>{{ .Title }}|{{ .Prizes.Winner.ParticipantName }}|{{ len .Sweepstake.Participants }}`)
+
+		gotMarkup, gotErr := sweepstake.GenerateMarkupWith(tpl)
+		cmpError(t, nil, gotErr)
+		cmpDiff(t, "<h1>Override</h1>Test Sweepstake 1|TBC|2", string(gotMarkup))
+
+		// tournament template must be unaffected
+		gotMarkup, gotErr = sweepstake.GenerateMarkup()
+		cmpError(t, nil, gotErr)
+		cmpDiff(t, "<h1>Original</h1>", string(gotMarkup))
+	})
+
+	t.Run("override template that has already been executed must be rendered", func(t *testing.T) {
+		tpl := parseTemplate(t, `<h1>Override</h1>{{ .Title }}`)
+		if err := tpl.Execute(io.Discard, struct{ Title string }{}); err != nil {
+			t.Fatal(err)
+		}
+
+		for i := 0; i < 2; i++ {
+			gotMarkup, gotErr := sweepstake.GenerateMarkupWith(tpl)
+			cmpError(t, nil, gotErr)
+			cmpDiff(t, "<h1>Override</h1>Test Sweepstake 1", string(gotMarkup))
+		}
+	})
+
+	t.Run("nil override template must produce the expected error", func(t *testing.T) {
+		gotMarkup, gotErr := sweepstake.GenerateMarkupWith(nil)
+		cmpError(t, fmt.Errorf("template: %w", domain.ErrIsEmpty), gotErr)
+		cmpDiff(t, []byte(nil), gotMarkup)
+	})
+}
+
+func TestSweepstake_GenerateMarkup_WithLang(t *testing.T) {
+	tpl, err := template.New("tpl").
+		Funcs(template.FuncMap{"t": func(label string) string { return label }}).
+		Parse(`<h2>{{ t .Prizes.Winner.PrizeName }}</h2><h3>{{ t .Prizes.Winner.ParticipantName }}</h3><p>{{ t "None yet!" }}</p>`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tournament := &domain.Tournament{
+		Teams:    domain.TeamCollection{teamA, teamB},
+		Template: tpl,
+	}
+
+	newSweepstake := func(lang string) *domain.Sweepstake {
+		return &domain.Sweepstake{
+			Name:         "Test Sweepstake 1",
+			Tournament:   tournament,
+			Participants: domain.ParticipantCollection{participantA, participantB},
+			Prizes:       domain.PrizeSettings{Winner: true},
+			Lang:         lang,
+			Translations: domain.Translations{
+				"fr": {
+					"Tournament Winner": "Vainqueur du tournoi",
+					"TBC":               "À confirmer",
+				},
+			},
+		}
+	}
+
+	// each sweepstake shares the tournament's template, so must be rendered in its own language regardless of the order of rendering
+	tt := []struct {
+		name         string
+		lang         string
+		wantMarkup   string
+		wantWarnings []string
+	}{
+		{
+			name:       "sweepstake without language must be rendered with english labels",
+			wantMarkup: "<h2>Tournament Winner</h2><h3>TBC</h3><p>None yet!</p>",
+		},
+		{
+			name:       "sweepstake with second language must be rendered with translated labels, falling back to english labels",
+			lang:       "fr",
+			wantMarkup: "<h2>Vainqueur du tournoi</h2><h3>À confirmer</h3><p>None yet!</p>",
+			wantWarnings: []string{
+				"lang 'fr': label 'None yet!': translation not found, falling back to en",
+			},
+		},
+		{
+			name:       "sweepstake with english language must be rendered with english labels",
+			lang:       "en",
+			wantMarkup: "<h2>Tournament Winner</h2><h3>TBC</h3><p>None yet!</p>",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			sweepstake := newSweepstake(tc.lang)
+
+			// each render must reuse the sweepstake's copy of the template, and report a missing translation only once
+			for i := 0; i < 2; i++ {
+				gotMarkup, gotErr := sweepstake.GenerateMarkup()
+				cmpError(t, nil, gotErr)
+				cmpDiff(t, tc.wantMarkup, string(gotMarkup))
+			}
+
+			var gotWarnings []string
+			for _, err := range sweepstake.RenderWarnings() {
+				gotWarnings = append(gotWarnings, err.Error())
+			}
+			cmpDiff(t, tc.wantWarnings, gotWarnings)
+		})
+	}
+}
+
+func TestSweepstake_GenerateMarkupVariant(t *testing.T) {
+	sweepstake := &domain.Sweepstake{
+		Name: "Test Sweepstake 1",
+		Tournament: &domain.Tournament{
+			Name:     "Test Tournament 1",
+			Teams:    domain.TeamCollection{teamA, teamB},
+			Template: parseTemplate(t, `<h1>{{ .Title }}</h1>`),
+			Variants: map[string]*template.Template{
+				"mobile": parseTemplate(t, `<p>{{ .Title }} (mobile)</p>`),
+			},
+		},
+		Participants: domain.ParticipantCollection{participantA, participantB},
+	}
+
+	tt := []struct {
+		name       string
+		variant    string
+		wantMarkup string
+	}{
+		{
+			name:       "present variant must be rendered",
+			variant:    "mobile",
+			wantMarkup: "<p>Test Sweepstake 1 (mobile)</p>",
+		},
+		{
+			name:       "absent variant must fall back to tournament template",
+			variant:    "tablet",
+			wantMarkup: "<h1>Test Sweepstake 1</h1>",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			gotMarkup, gotErr := sweepstake.GenerateMarkupVariant(tc.variant)
+			cmpError(t, nil, gotErr)
+			cmpDiff(t, tc.wantMarkup, string(gotMarkup))
+		})
+	}
+}
+
+func TestSweepstake_GenerateParticipantMarkup(t *testing.T) {
+	tpl := parseTemplate(t, `<h1>{{ .Title }}</h1><img src="{{ .ImageURL }}">{{ .Summary }}|`+
+		`{{ range .Results }}{{ .ID }} {{ .Home.Goals }}-{{ .Away.Goals }};{{ end }}|`+
+		`{{ range .Fixtures }}{{ .ID }};{{ end }}|`+
+		`{{ range .Winning }}{{ .PrizeName }};{{ end }}`)
+
+	newSweepstake := func() *domain.Sweepstake {
+		return &domain.Sweepstake{
+			Tournament: &domain.Tournament{
+				ID:    "TestTourney1",
+				Teams: domain.TeamCollection{teamA, teamB, teamC},
+				Matches: domain.MatchCollection{
+					{
+						ID:        "M1",
+						Completed: true,
+						Home:      domain.MatchCompetitor{Team: teamA, Goals: 2},
+						Away:      domain.MatchCompetitor{Team: teamB, Goals: 1},
+						Winner:    teamA,
+					},
+					{
+						ID:        "M2",
+						Completed: true,
+						Home:      domain.MatchCompetitor{Team: teamB, Goals: 3},
+						Away:      domain.MatchCompetitor{Team: teamC, Goals: 0},
+						Winner:    teamB,
+					},
+					{
+						ID:   "M3",
+						Home: domain.MatchCompetitor{Team: teamC},
+						Away: domain.MatchCompetitor{Team: teamA},
+					},
+				},
+				ParticipantTemplate: tpl,
+			},
+			Participants: domain.ParticipantCollection{
+				participantA,
+				{TeamID: "teamB"},
+				participantC,
+			},
+			Prizes: domain.PrizeSettings{Winner: true, MostGoalsConceded: true},
+		}
+	}
+
+	tt := []struct {
+		name        string
+		sweepstake  *domain.Sweepstake
+		participant func(s *domain.Sweepstake) *domain.Participant
+		wantMarkup  string
+		wantErr     error
+	}{
+		{
+			name:        "participant must be rendered with their team's resolved data",
+			sweepstake:  newSweepstake(),
+			participant: func(s *domain.Sweepstake) *domain.Participant { return s.Participants[0] },
+			wantMarkup:  `<h1>Marc Pugh</h1><img src="http://teamA.jpg">Marc Pugh (Team A)|M1 2-1;|M3;|`,
+		},
+		{
+			name:        "participant who is winning a prize must be rendered with the prize",
+			sweepstake:  newSweepstake(),
+			participant: func(s *domain.Sweepstake) *domain.Participant { return s.Participants[2] },
+			wantMarkup:  `<h1>Brett Pitman</h1><img src="http://teamC.jpg">Brett Pitman (Team C)|M2 3-0;|M3;|Most Goals Conceded;`,
+		},
+		{
+			name:        "participant without name must be rendered with their team name",
+			sweepstake:  newSweepstake(),
+			participant: func(s *domain.Sweepstake) *domain.Participant { return s.Participants[1] },
+			wantMarkup:  `<h1>Team B</h1><img src="http://teamB.jpg">Team B|M1 2-1;M2 3-0;||`,
+		},
+		{
+			name:        "participant with unknown team must produce the expected error",
+			sweepstake:  newSweepstake(),
+			participant: func(s *domain.Sweepstake) *domain.Participant { return &domain.Participant{TeamID: "teamZ"} },
+			wantErr:     fmt.Errorf("participant team id 'teamZ': %w", domain.ErrNotFound),
+		},
+		{
+			name:        "nil participant must produce the expected error",
+			sweepstake:  newSweepstake(),
+			participant: func(s *domain.Sweepstake) *domain.Participant { return nil },
+			wantErr:     fmt.Errorf("participant: %w", domain.ErrIsEmpty),
+		},
+		{
+			name: "tournament without participant template must produce the expected error",
+			sweepstake: func() *domain.Sweepstake {
+				s := newSweepstake()
+				s.Tournament.ParticipantTemplate = nil
+				return s
+			}(),
+			participant: func(s *domain.Sweepstake) *domain.Participant { return s.Participants[0] },
+			wantErr:     fmt.Errorf("tournament 'TestTourney1' has no participant markup: template: %w", domain.ErrIsEmpty),
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			gotMarkup, gotErr := tc.sweepstake.GenerateParticipantMarkup(tc.participant(tc.sweepstake))
+			cmpError(t, tc.wantErr, gotErr)
+			if tc.wantErr == nil {
+				cmpDiff(t, tc.wantMarkup, string(gotMarkup))
+			}
+		})
+	}
+}
+
+func TestSweepstake_GenerateParticipantMarkup_WithAssignments(t *testing.T) {
+	tpl := parseTemplate(t, `{{ .Summary }}|{{ range .Winning }}{{ .PrizeName }};{{ end }}`)
+
+	// team A wins the final, and is reassigned to Steve Fletcher afterwards
+	reassigned := &domain.Participant{
+		TeamID: "teamB",
+		Name:   "Steve Fletcher",
+		Assignments: []domain.TeamAssignment{
+			{TeamID: "teamA", From: date2},
+		},
+	}
+
+	sweepstake := &domain.Sweepstake{
+		Tournament: &domain.Tournament{
+			Teams: domain.TeamCollection{teamA, teamB},
+			Matches: domain.MatchCollection{
+				{
+					ID:        "F",
+					Timestamp: date1,
+					Stage:     domain.KnockoutStage,
+					Completed: true,
+					Home:      domain.MatchCompetitor{Team: teamA, Goals: 1},
+					Away:      domain.MatchCompetitor{Team: teamB, Goals: 0},
+					Winner:    teamA,
+				},
+			},
+			ParticipantTemplate: tpl,
+			Clock:               &fakeClock{Timestamp: date3},
+		},
+		Participants: domain.ParticipantCollection{participantA, reassigned},
+		Prizes:       domain.PrizeSettings{Winner: true},
+	}
+
+	tt := []struct {
+		name        string
+		participant *domain.Participant
+		wantMarkup  string
+	}{
+		{
+			name:        "participant who was assigned the winning team when it won the prize must be rendered with the prize",
+			participant: participantA,
+			wantMarkup:  `Steve Fletcher (Team A)|Tournament Winner;`,
+		},
+		{
+			name:        "participant who was assigned the winning team after it won the prize must not be rendered with the prize",
+			participant: reassigned,
+			wantMarkup:  `Steve Fletcher (Team B)|`,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			gotMarkup, gotErr := sweepstake.GenerateParticipantMarkup(tc.participant)
+			cmpError(t, nil, gotErr)
+			cmpDiff(t, tc.wantMarkup, string(gotMarkup))
+		})
+	}
+}
+
+func TestSweepstake_SummaryFor(t *testing.T) {
+	tt := []struct {
+		name           string
+		unclaimedLabel string
+		team           *domain.Team
+		wantSummary    string
+	}{
+		{
+			name:        "team with participant must be summarised with participant name",
+			team:        teamA,
+			wantSummary: "Marc Pugh (Team A)",
+		},
+		{
+			name:        "team declared as unclaimed must be summarised with default unclaimed label",
+			team:        teamC,
+			wantSummary: "Unclaimed (Team C)",
+		},
+		{
+			name:           "team declared as unclaimed must be summarised with tournament unclaimed label",
+			unclaimedLabel: "Up For Grabs",
+			team:           teamC,
+			wantSummary:    "Up For Grabs (Team C)",
+		},
+		{
+			name:        "team without participant that is not declared as unclaimed must be summarised with team name only",
+			team:        teamD,
+			wantSummary: "Team D",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			sweepstake := &domain.Sweepstake{
+				Tournament: &domain.Tournament{
+					Teams:          domain.TeamCollection{teamA, teamB, teamC, teamD},
+					UnclaimedLabel: tc.unclaimedLabel,
+				},
+				Participants:     domain.ParticipantCollection{participantA, participantB},
+				UnclaimedTeamIDs: []string{"teamC"},
+			}
+
+			cmpDiff(t, tc.wantSummary, sweepstake.SummaryFor(tc.team))
+		})
+	}
+}
+
+func TestSweepstake_SummaryFor_WithAssignments(t *testing.T) {
+	reassigned := &domain.Participant{
+		TeamID: "teamB",
+		Name:   "Steve Fletcher",
+		Assignments: []domain.TeamAssignment{
+			{TeamID: "teamA", From: date2},
+		},
+	}
+
+	tt := []struct {
+		name        string
+		now         time.Time
+		team        *domain.Team
+		wantSummary string
+	}{
+		{
+			name:        "team before its reassignment must be summarised with original participant name",
+			now:         date1,
+			team:        teamA,
+			wantSummary: "Marc Pugh (Team A)",
+		},
+		{
+			name:        "team from its reassignment must be summarised with reassigned participant name",
+			now:         date2,
+			team:        teamA,
+			wantSummary: "Steve Fletcher (Team A)",
+		},
+		{
+			name:        "team after its reassignment must be summarised with reassigned participant name",
+			now:         date3,
+			team:        teamA,
+			wantSummary: "Steve Fletcher (Team A)",
+		},
+		{
+			name:        "team that is not reassigned must be summarised with original participant name",
+			now:         date3,
+			team:        teamB,
+			wantSummary: "Steve Fletcher (Team B)",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			sweepstake := &domain.Sweepstake{
+				Tournament: &domain.Tournament{
+					Teams: domain.TeamCollection{teamA, teamB},
+					Clock: &fakeClock{Timestamp: tc.now},
+				},
+				Participants: domain.ParticipantCollection{participantA, reassigned},
+			}
+
+			cmpDiff(t, tc.wantSummary, sweepstake.SummaryFor(tc.team))
+		})
+	}
+}
+
+func TestSweepstake_GenerateMarkup_EnabledPrizes(t *testing.T) {
+	tpl := `{{ range .PrizeOrder }}{{ . }}|{{ end }}`
+
+	tt := []struct {
+		name          string
+		enabledPrizes []string
+		wantMarkup    string
+	}{
+		{
+			name:       "no enabled prizes must render every prize that is enabled by settings",
+			wantMarkup: "winner|wooden_spoon|most_goals_conceded|",
+		},
+		{
+			name:          "enabled prizes must only render prizes that are enabled by both settings and flags",
+			enabledPrizes: []string{"wooden_spoon", "most_goals_conceded", "quickest_red_card"},
+			wantMarkup:    "wooden_spoon|most_goals_conceded|",
+		},
+		{
+			name:          "enabled prizes that are not enabled by settings must not render any prizes",
+			enabledPrizes: []string{"quickest_red_card"},
+			wantMarkup:    "",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			sweepstake := &domain.Sweepstake{
+				Tournament: &domain.Tournament{
+					Teams:    domain.TeamCollection{teamA, teamB},
+					Template: parseTemplate(t, tpl),
+				},
+				Participants: domain.ParticipantCollection{participantA, participantB},
+				Prizes: domain.PrizeSettings{
+					Winner:            true,
+					WoodenSpoon:       true,
+					MostGoalsConceded: true,
+				},
+				EnabledPrizes: tc.enabledPrizes,
+			}
+
+			gotMarkup, gotErr := sweepstake.GenerateMarkup()
+			cmpError(t, nil, gotErr)
+			cmpDiff(t, tc.wantMarkup, string(gotMarkup))
+		})
+	}
+}
+
+func TestSweepstake_GenerateMarkup_Description(t *testing.T) {
+	tt := []struct {
+		name        string
+		description string
+		trusted     bool
+		wantMarkup  string
+	}{
+		{
+			name:        "description must be html-escaped by default",
+			description: `Welcome to the <b>sweepstake</b> & <script>alert("hi")</script>`,
+			wantMarkup:  `<p>Welcome to the &lt;b&gt;sweepstake&lt;/b&gt; &amp; &lt;script&gt;alert(&#34;hi&#34;)&lt;/script&gt;</p>`,
+		},
+		{
+			name:        "trusted description must be rendered as-is",
+			description: `Welcome to the <b>sweepstake</b> & good luck!`,
+			trusted:     true,
+			wantMarkup:  `<p>Welcome to the <b>sweepstake</b> & good luck!</p>`,
+		},
+		{
+			name:       "empty description must not be rendered",
+			wantMarkup: ``,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			sweepstake := &domain.Sweepstake{
+				Description:        tc.description,
+				DescriptionTrusted: tc.trusted,
+				Tournament: &domain.Tournament{
+					Template: parseTemplate(t, `{{ with .Description }}<p>{{ . }}</p>{{ end }}`),
+				},
+			}
+
+			gotMarkup, gotErr := sweepstake.GenerateMarkup()
+			cmpError(t, nil, gotErr)
+			cmpDiff(t, tc.wantMarkup, string(gotMarkup))
+		})
+	}
+}
+
+func TestSweepstake_GenerateMarkup_NoTemplate(t *testing.T) {
+	t.Run("tournament without template must produce the expected error", func(t *testing.T) {
+		sweepstake := &domain.Sweepstake{
+			Tournament: &domain.Tournament{ID: "TestTourney1"},
+		}
+
+		gotMarkup, gotErr := sweepstake.GenerateMarkup()
+		cmpError(t, errors.New("tournament 'TestTourney1' has no markup: template: is empty"), gotErr)
+		cmpDiff(t, []byte(nil), gotMarkup)
+	})
+
+	t.Run("no tournament must produce the expected error", func(t *testing.T) {
+		_, gotErr := (&domain.Sweepstake{}).GenerateMarkup()
+		cmpError(t, domain.ErrIsEmpty, gotErr)
+	})
+}
+
+func TestSweepstake_GenerateMarkup_DisabledPrize(t *testing.T) {
+	newSweepstake := func(tpl string) *domain.Sweepstake {
+		return &domain.Sweepstake{
+			Tournament: &domain.Tournament{
+				Teams:    domain.TeamCollection{teamA, teamB},
+				Template: parseTemplate(t, tpl),
+			},
+			Participants: domain.ParticipantCollection{participantA, participantB},
+			Prizes:       domain.PrizeSettings{Winner: true},
+		}
+	}
+
+	t.Run("template that dereferences a disabled prize must produce the expected error", func(t *testing.T) {
+		sweepstake := newSweepstake(`{{ .Prizes.Winner.ParticipantName }}|{{ .Prizes.RunnerUp.ParticipantName }}`)
+
+		gotMarkup, gotErr := sweepstake.GenerateMarkup()
+		if gotErr == nil {
+			t.Fatal("want error, got nil")
+		}
+		wantPrefix := "cannot execute template: prize 'RunnerUp' is disabled; guard with {{ with .Prizes.RunnerUp }}: "
+		if !strings.HasPrefix(gotErr.Error(), wantPrefix) {
+			t.Fatalf("want error with prefix %q, got %q", wantPrefix, gotErr.Error())
+		}
+		cmpDiff(t, []byte(nil), gotMarkup)
+	})
+
+	t.Run("template that guards a disabled prize must not produce an error", func(t *testing.T) {
+		sweepstake := newSweepstake(`{{ .Prizes.Winner.ParticipantName }}|{{ with .Prizes.RunnerUp }}{{ .ParticipantName }}{{ end }}`)
+
+		gotMarkup, gotErr := sweepstake.GenerateMarkup()
+		cmpError(t, nil, gotErr)
+		cmpDiff(t, "TBC|", string(gotMarkup))
+	})
+
+	t.Run("template error that does not relate to a disabled prize must not reference a prize", func(t *testing.T) {
+		sweepstake := newSweepstake(`{{ index .Sweepstake.Participants 5 }}`)
+
+		_, gotErr := sweepstake.GenerateMarkup()
+		if gotErr == nil {
+			t.Fatal("want error, got nil")
+		}
+		if strings.Contains(gotErr.Error(), "is disabled") {
+			t.Fatalf("want error without disabled prize guidance, got %q", gotErr.Error())
+		}
+	})
+}
+
+func TestSweepstake_GeneratePrizeText(t *testing.T) {
+	tournament := &domain.Tournament{
+		Teams: domain.TeamCollection{teamA, teamB, teamC},
+		Matches: domain.MatchCollection{
+			{
+				ID:        "SF",
+				Completed: true,
+				Home:      domain.MatchCompetitor{Team: teamB, Goals: 1},
+				Away:      domain.MatchCompetitor{Team: teamC, Goals: 3},
+				Winner:    teamC,
+			},
+			{
+				ID:        "F",
+				Completed: true,
+				Home:      domain.MatchCompetitor{Team: teamA, Goals: 2},
+				Away:      domain.MatchCompetitor{Team: teamC, Goals: 1},
+				Winner:    teamA,
+			},
+		},
+	}
+
+	tt := []struct {
+		name       string
+		sweepstake *domain.Sweepstake
+		wantText   string
+		wantErr    error
+	}{
+		{
+			name: "enabled prizes must be summarised and disabled prizes must be omitted",
+			sweepstake: &domain.Sweepstake{
+				Tournament:   tournament,
+				Participants: domain.ParticipantCollection{participantA, participantB, participantC},
+				Prizes: domain.PrizeSettings{
+					Winner:            true,
+					MostGoalsConceded: true,
+					QuickestRedCard:   true,
+				},
+			},
+			wantText: "Tournament Winner\n" +
+				"Marc Pugh (Team A)\n" +
+				"\n" +
+				"Most Goals Conceded\n" +
+				"1. Steve Fletcher (Team B) ⚽️ 3\n" +
+				"2. Brett Pitman (Team C) ⚽️ 3\n" +
+				"3. Marc Pugh (Team A) ⚽️ 1\n" +
+				"\n" +
+				"Quickest Red Card\n" +
+				"None yet",
+		},
+		{
+			name: "no enabled prizes must return empty text",
+			sweepstake: &domain.Sweepstake{
+				Tournament:   tournament,
+				Participants: domain.ParticipantCollection{participantA, participantB, participantC},
+			},
+			// want empty text
+		},
+		{
+			name:       "no tournament must produce the expected error",
+			sweepstake: &domain.Sweepstake{},
+			wantErr:    domain.ErrIsEmpty,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			gotText, gotErr := tc.sweepstake.GeneratePrizeText()
+			cmpError(t, tc.wantErr, gotErr)
+			cmpDiff(t, tc.wantText, gotText)
+		})
+	}
+}
+
+func TestSweepstake_TopRankedAsOutright(t *testing.T) {
+	tournament := &domain.Tournament{
+		Teams: domain.TeamCollection{teamA, teamB},
+		Matches: domain.MatchCollection{
+			{
+				ID:        "F",
+				Completed: true,
+				Home:      domain.MatchCompetitor{Team: teamA, Goals: 2, YellowCards: 1},
+				Away:      domain.MatchCompetitor{Team: teamB, Goals: 1, YellowCards: 3},
+				Winner:    teamA,
+			},
+		},
+	}
+
+	participants := domain.ParticipantCollection{participantA, participantB}
+
+	tt := []struct {
+		name       string
+		sweepstake *domain.Sweepstake
+		wantPrizes []domain.OutrightPrize
+	}{
+		{
+			name: "enabled ranked prizes must produce their top-ranked participant in display order",
+			sweepstake: &domain.Sweepstake{
+				Tournament:   tournament,
+				Participants: participants,
+				Prizes: domain.PrizeSettings{
+					Winner:            true, // outright prizes must be ignored
+					MostYellowCards:   true,
+					MostGoalsConceded: true,
+				},
+			},
+			wantPrizes: []domain.OutrightPrize{
+				{PrizeName: "Most Goals Conceded", ParticipantName: "Steve Fletcher (Team B)", ImageURL: "http://teamB.jpg"},
+				{PrizeName: "Most Yellow Cards", ParticipantName: "Steve Fletcher (Team B)", ImageURL: "http://teamB.jpg"},
+			},
+		},
+		{
+			name: "enabled ranked prize without rankings must produce an unresolved prize",
+			sweepstake: &domain.Sweepstake{
+				Tournament:   tournament,
+				Participants: participants,
+				Prizes: domain.PrizeSettings{
+					MostGoalsConceded: true,
+					QuickestRedCard:   true, // no red cards
+				},
+			},
+			wantPrizes: []domain.OutrightPrize{
+				{PrizeName: "Most Goals Conceded", ParticipantName: "Steve Fletcher (Team B)", ImageURL: "http://teamB.jpg"},
+				{PrizeName: "Quickest Red Card", ParticipantName: "TBC"},
+			},
+		},
+		{
+			name: "no enabled ranked prizes must produce no prizes",
+			sweepstake: &domain.Sweepstake{
+				Tournament:   tournament,
+				Participants: participants,
+				Prizes:       domain.PrizeSettings{Winner: true, RunnerUp: true},
+			},
+			// want no prizes
+		},
+		{
+			name: "nil sweepstake must produce no prizes",
+			// want no prizes
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			gotPrizes := tc.sweepstake.TopRankedAsOutright()
+			cmpDiff(t, tc.wantPrizes, gotPrizes)
+		})
+	}
+}
+
+func TestSweepstake_GeneratePrizeJSON(t *testing.T) {
+	now := time.Date(2018, 5, 26, 23, 30, 0, 0, time.UTC)
+
+	sweepstake := &domain.Sweepstake{
+		ID: "test-sweepstake-1",
+		Tournament: &domain.Tournament{
+			Teams: domain.TeamCollection{teamA, teamB},
+			Matches: domain.MatchCollection{
+				{
+					ID:        "F",
+					Completed: true,
+					Home:      domain.MatchCompetitor{Team: teamA, Goals: 2},
+					Away:      domain.MatchCompetitor{Team: teamB, Goals: 1},
+					Winner:    teamA,
+				},
+			},
+			Location: time.FixedZone("Europe/London", 3600),
+		},
+		Participants: domain.ParticipantCollection{participantA, participantB},
+		Prizes: domain.PrizeSettings{
+			Winner:            true,
+			MostGoalsConceded: true,
+			QuickestRedCard:   true,
+		},
+	}
+
+	tt := []struct {
+		name     string
+		pretty   bool
+		wantJSON string
+	}{
+		{
+			name:   "compact json must be generated",
+			pretty: false,
+			wantJSON: `{"sweepstake_id":"test-sweepstake-1","generated_at":"2018-05-27T00:30:00+01:00",` +
+				`"outright":[{"prize_name":"Tournament Winner","participant_name":"Marc Pugh (Team A)","image_url":"http://teamA.jpg"}],` +
+				`"ranked":[{"prize_name":"Most Goals Conceded","rankings":[` +
+				`{"position":1,"image_url":"http://teamB.jpg","participant_name":"Steve Fletcher (Team B)","value":"⚽️ 2"},` +
+				`{"position":2,"image_url":"http://teamA.jpg","participant_name":"Marc Pugh (Team A)","value":"⚽️ 1"}]},` +
+				`{"prize_name":"Quickest Red Card","rankings":[]}]}`,
+		},
+		{
+			name:   "pretty json must be indented",
+			pretty: true,
+			wantJSON: `{
+  "sweepstake_id": "test-sweepstake-1",
+  "generated_at": "2018-05-27T00:30:00+01:00",
+  "outright": [
+    {
+      "prize_name": "Tournament Winner",
+      "participant_name": "Marc Pugh (Team A)",
+      "image_url": "http://teamA.jpg"
+    }
+  ],
+  "ranked": [
+    {
+      "prize_name": "Most Goals Conceded",
+      "rankings": [
+        {
+          "position": 1,
+          "image_url": "http://teamB.jpg",
+          "participant_name": "Steve Fletcher (Team B)",
+          "value": "⚽️ 2"
+        },
+        {
+          "position": 2,
+          "image_url": "http://teamA.jpg",
+          "participant_name": "Marc Pugh (Team A)",
+          "value": "⚽️ 1"
+        }
+      ]
+    },
+    {
+      "prize_name": "Quickest Red Card",
+      "rankings": []
+    }
+  ]
+}`,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			gotFirst, err := sweepstake.GeneratePrizeJSON(now, tc.pretty)
+			cmpError(t, nil, err)
+
+			gotSecond, err := sweepstake.GeneratePrizeJSON(now, tc.pretty)
+			cmpError(t, nil, err)
+
+			// output must be identical across runs
+			if !bytes.Equal(gotFirst, gotSecond) {
+				t.Fatalf("want identical output, got '%s' and '%s'", gotFirst, gotSecond)
+			}
+			cmpDiff(t, tc.wantJSON, string(gotFirst))
+		})
+	}
+
+	t.Run("zero time must omit generated at", func(t *testing.T) {
+		gotJSON, err := sweepstake.GeneratePrizeJSON(time.Time{}, false)
+		cmpError(t, nil, err)
+
+		wantJSON := `{"sweepstake_id":"test-sweepstake-1",` +
+			`"outright":[{"prize_name":"Tournament Winner","participant_name":"Marc Pugh (Team A)","image_url":"http://teamA.jpg"}],` +
+			`"ranked":[{"prize_name":"Most Goals Conceded","rankings":[` +
+			`{"position":1,"image_url":"http://teamB.jpg","participant_name":"Steve Fletcher (Team B)","value":"⚽️ 2"},` +
+			`{"position":2,"image_url":"http://teamA.jpg","participant_name":"Marc Pugh (Team A)","value":"⚽️ 1"}]},` +
+			`{"prize_name":"Quickest Red Card","rankings":[]}]}`
+		cmpDiff(t, wantJSON, string(gotJSON))
+	})
+
+	t.Run("no tournament must produce the expected error", func(t *testing.T) {
+		_, gotErr := (&domain.Sweepstake{}).GeneratePrizeJSON(now, false)
+		cmpError(t, domain.ErrIsEmpty, gotErr)
+	})
+}
+
+func TestSweepstakeCollection_DiffMarkup(t *testing.T) {
+	newSweepstake := func(id, markup string) *domain.Sweepstake {
+		return &domain.Sweepstake{
+			ID:         id,
+			Name:       id,
+			Tournament: &domain.Tournament{Template: parseTemplate(t, markup)},
+		}
+	}
+
+	collection := domain.SweepstakeCollection{
+		newSweepstake("unchanged", "<h1>{{ .Title }}</h1>"),
+		newSweepstake("changed", "<h1>{{ .Title }}</h1>"),
+		newSweepstake("new", "<h1>{{ .Title }}</h1>"),
+	}
+
+	tt := []struct {
+		name        string
+		collection  domain.SweepstakeCollection
+		prev        map[string][]byte
+		wantChanged []string
+		wantErr     error
+	}{
+		{
+			name:       "changed and new sweepstakes must be returned",
+			collection: collection,
+			prev: map[string][]byte{
+				"unchanged": []byte("<h1>unchanged</h1>"),
+				"changed":   []byte("<h1>previous</h1>"),
+			},
+			wantChanged: []string{"changed", "new"},
+		},
+		{
+			name:       "identical markup must return no changes",
+			collection: collection[:1],
+			prev: map[string][]byte{
+				"unchanged": []byte("<h1>unchanged</h1>"),
+			},
+			// want no changes
+		},
+		{
+			name:        "no previous markup must return all sweepstakes",
+			collection:  collection,
+			wantChanged: []string{"unchanged", "changed", "new"},
+		},
+		{
+			name:       "failure to generate markup must produce the expected error",
+			collection: domain.SweepstakeCollection{newSweepstake("broken", "{{ .Sweepstake.NotAField }}")},
+			wantErr: errors.New(`sweepstake 'broken': cannot execute template: template: tpl:1:14: executing "tpl" at <.Sweepstake.NotAField>: ` +
+				`can't evaluate field NotAField in type *domain.Sweepstake`),
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			gotChanged, gotErr := tc.collection.DiffMarkup(tc.prev)
+			cmpError(t, tc.wantErr, gotErr)
+			cmpDiff(t, tc.wantChanged, gotChanged)
+		})
+	}
+}
+
+func TestSweepstakeCollection_DiffEncodedMarkup(t *testing.T) {
+	tpl := parseTemplate(t, "<h1>{{ .Title }}</h1>")
+	collection := domain.SweepstakeCollection{
+		{ID: "unchanged", Name: "unchanged", Tournament: &domain.Tournament{Template: tpl}},
+		{ID: "changed", Name: "changed", Tournament: &domain.Tournament{Template: tpl}},
+	}
+
+	encode := func(b []byte) []byte {
+		return append([]byte("<!-- encoded -->"), b...)
+	}
+
+	prev := map[string][]byte{
+		"unchanged": []byte("<!-- encoded --><h1>unchanged</h1>"),
+		"changed":   []byte("<h1>changed</h1>"), // written before encoding was applied
+	}
+
+	t.Run("encoded markup must be compared", func(t *testing.T) {
+		gotChanged, gotErr := collection.DiffEncodedMarkup(prev, encode)
+		cmpError(t, nil, gotErr)
+		cmpDiff(t, []string{"changed"}, gotChanged)
+	})
+
+	t.Run("no encoding must compare markup as-is", func(t *testing.T) {
+		gotChanged, gotErr := collection.DiffEncodedMarkup(prev, nil)
+		cmpError(t, nil, gotErr)
+		cmpDiff(t, []string{"unchanged"}, gotChanged)
+	})
+}
+
+func TestSweepstakeCollection_IndexJSON(t *testing.T) {
+	tournament := &domain.Tournament{
+		ID:       "TestTourney1",
+		ImageURL: "http://tourney.jpg",
+	}
+
+	collection := domain.SweepstakeCollection{
+		{ID: "Test Sweepstake 1", Name: "Test Sweepstake 1", Tournament: tournament, Build: true},
+		{ID: "test-sweepstake-2", Name: "Test Sweepstake 2", Tournament: tournament}, // not built, should be excluded
+		{ID: "test-sweepstake-3", Name: "Test Sweepstake 3", Tournament: tournament, Build: true},
+	}
+
+	tt := []struct {
+		name       string
+		collection domain.SweepstakeCollection
+		baseURL    string
+		wantJSON   string
+		wantErr    error
+	}{
+		{
+			name:       "built sweepstakes must be indexed relative to base url",
+			collection: collection,
+			baseURL:    "https://example.com/sweepstakes/",
+			wantJSON: `{"sweepstakes":[` +
+				`{"id":"Test Sweepstake 1","name":"Test Sweepstake 1","image_url":"http://tourney.jpg","tournament_id":"TestTourney1","url":"https://example.com/sweepstakes/test-sweepstake-1/"},` +
+				`{"id":"test-sweepstake-3","name":"Test Sweepstake 3","image_url":"http://tourney.jpg","tournament_id":"TestTourney1","url":"https://example.com/sweepstakes/test-sweepstake-3/"}]}`,
+		},
+		{
+			name:       "empty base url must produce root-relative urls",
+			collection: collection[:1],
+			wantJSON: `{"sweepstakes":[` +
+				`{"id":"Test Sweepstake 1","name":"Test Sweepstake 1","image_url":"http://tourney.jpg","tournament_id":"TestTourney1","url":"/test-sweepstake-1/"}]}`,
+		},
+		{
+			name:       "no built sweepstakes must produce an empty index",
+			collection: collection[1:2],
+			wantJSON:   `{"sweepstakes":[]}`,
+		},
+		{
+			name:       "built sweepstake without tournament must produce the expected error",
+			collection: domain.SweepstakeCollection{{ID: "test-sweepstake-1", Build: true}},
+			wantErr:    errors.New("sweepstake 'test-sweepstake-1': tournament: is empty"),
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			gotJSON, gotErr := tc.collection.IndexJSON(tc.baseURL)
+			cmpError(t, tc.wantErr, gotErr)
+			if tc.wantErr == nil {
+				cmpDiff(t, tc.wantJSON, string(gotJSON))
+			}
+		})
+	}
+}
+
+func TestSweepstakesJSONLoader_LoadSweepstakes(t *testing.T) {
+	testTourney1 := &domain.Tournament{
+		ID: "TestTourney1",
+		Teams: domain.TeamCollection{
+			{ID: "BPFC"},
+			{ID: "DTFC"},
+			{ID: "DYFC"},
+			{ID: "HUFC"},
+			{ID: "PTFC"},
+			{ID: "SJRFC"},
+			{ID: "STHFC"},
+			{ID: "WTFC"},
+		},
+	}
+
+	testTourney2 := &domain.Tournament{
+		ID: "TestTourney2",
+		Teams: domain.TeamCollection{
+			{ID: "ABC"},
+			{ID: "DEF"},
+		},
+	}
+
+	testTourney3 := &domain.Tournament{
+		ID: "TestTourney3",
+		Teams: domain.TeamCollection{
+			{ID: "ABC"},
+			{ID: "DEF"},
+			{ID: "GHI"},
+		},
+		Matches: domain.MatchCollection{
+			{
+				Home: domain.MatchCompetitor{Team: &domain.Team{ID: "ABC"}},
+				Away: domain.MatchCompetitor{Team: &domain.Team{ID: "DEF"}},
+			},
+			// GHI does not appear in any match
+		},
+	}
+
+	defaultTestTournaments := domain.TournamentCollection{
+		testTourney1,
+		testTourney2,
+		testTourney3,
+	}
+
+	caseInsensitiveTestTourney2 := &domain.Tournament{
+		ID:                     "TestTourney2",
+		Teams:                  testTourney2.Teams,
+		CaseInsensitiveTeamIDs: true,
+	}
+
+	tt := []struct {
+		name            string
+		tournaments     domain.TournamentCollection
+		configFilename  string
+		wantSweepstakes domain.SweepstakeCollection
+		wantErr         error
+	}{
+		{
+			name:           "valid sweepstake json must be loaded successfully",
+			tournaments:    defaultTestTournaments,
+			configFilename: "sweepstakes_ok.json",
+			wantSweepstakes: domain.SweepstakeCollection{
+				{
+					ID:          "test-sweepstake-1",
+					Name:        "Test Sweepstake 1",
+					Headline:    "Check out <a href=\"https://www.youtube.com/watch?v=dQw4w9WgXcQ\">this thing</a>!",
+					Description: "Welcome to the <b>sweepstake</b> - good luck!",
+					Tournament:  testTourney1,
+					Participants: []*domain.Participant{
+						{TeamID: "BPFC", Name: "John L"},
+						{TeamID: "DTFC", Name: "Paul M"},
+						{TeamID: "DYFC", Name: "George H"},
+						{TeamID: "HUFC", Name: "Ringo S"},
+						{TeamID: "PTFC", Name: "Jon L"},
+						{TeamID: "SJRFC", Name: "Steve J"},
+						{TeamID: "STHFC", Name: "Paul C"},
+						{TeamID: "WTFC", Name: "Sid V / Glen M"},
+					},
+					Prizes: domain.PrizeSettings{
+						Winner:            true,
+						RunnerUp:          true,
+						MostGoalsConceded: true,
+						MostYellowCards:   true,
+						QuickestOwnGoal:   true,
+						QuickestRedCard:   true,
+					},
+					Build: true,
+				},
+				{
+					ID:         "test-sweepstake-2",
+					Name:       "Test Sweepstake 2",
+					Tournament: testTourney2,
+					Participants: []*domain.Participant{
+						{TeamID: "ABC", Name: "Dara"},
+						{TeamID: "DEF", Name: "Ed"},
+					},
+					Build: true,
+				},
+			},
+		},
+		{
+			name:    "empty tournaments must produce the expected error",
+			wantErr: domain.ErrIsEmpty,
+			// tournaments are empty
+		},
+		{
+			name:        "empty config filename must produce the expected error",
+			tournaments: defaultTestTournaments,
+			wantErr:     errors.New("cannot open file '': open : file does not exist"),
+			// configFilename is empty
+		},
+		{
+			name:           "non-existent config file must produce the expected error",
+			tournaments:    defaultTestTournaments,
+			configFilename: "non-existent.json",
+			wantErr:        fs.ErrNotExist,
+		},
+		{
+			name:           "invalid sweepstake format must produce the expected error",
+			tournaments:    defaultTestTournaments,
+			configFilename: "sweepstakes_unmarshalable.json",
+			wantErr: fmt.Errorf("cannot unmarshal sweepstakes: %w", &json.UnmarshalTypeError{
+				Value: "number",
+				Type:  reflect.TypeOf("string"),
+				Field: jsonFieldPath("sweepstakes", "0", "id"),
+			}),
+		},
+		{
+			name:           "no sweepstakes must produce the expected error",
+			tournaments:    defaultTestTournaments,
+			configFilename: "sweepstakes_none.json",
+			wantErr:        errors.New("no sweepstakes found in source data"),
+		},
+		{
+			name:           "non-existent tournament id must produce the expected error",
+			tournaments:    defaultTestTournaments,
+			configFilename: "sweepstakes_non_existent_tournament_id.json",
+			wantErr:        domain.ErrNotFound,
+		},
+		{
+			name:           "invalid sweepstake must produce the expected error",
+			tournaments:    defaultTestTournaments,
+			configFilename: "sweepstakes_invalid.json",
+			wantErr: newMultiError([]string{
+				"id: is empty",
+				"name: is empty",
+				"participant index 0: unrecognised participant team id: NOT_BPFC",
+				"1 team has no participant: BPFC",
+				"1 team has multiple participants: WTFC",
+				"team id 'BPFC': count 0",
+				"team id 'WTFC': count 2",
+			}),
+		},
+		{
+			name:           "sweepstake missing two teams must produce the expected error",
+			tournaments:    defaultTestTournaments,
+			configFilename: "sweepstakes_missing_teams.json",
+			wantErr: newMultiError([]string{
+				"2 teams have no participant: DYFC, SJRFC",
+				"team id 'DYFC': count 0",
+				"team id 'SJRFC': count 0",
+			}),
+		},
+		{
+			name:           "sweepstake with teams declared as unclaimed must be loaded successfully",
+			tournaments:    defaultTestTournaments,
+			configFilename: "sweepstakes_unclaimed_teams.json",
+			wantSweepstakes: domain.SweepstakeCollection{
+				{
+					ID:         "test-sweepstake-1",
+					Name:       "Test Sweepstake 1",
+					Tournament: testTourney1,
+					Participants: []*domain.Participant{
+						{TeamID: "BPFC", Name: "John L"},
+						{TeamID: "DTFC", Name: "Paul M"},
+						{TeamID: "HUFC", Name: "Ringo S"},
+						{TeamID: "PTFC", Name: "Jon L"},
+						{TeamID: "STHFC", Name: "Paul C"},
+						{TeamID: "WTFC", Name: "Sid V / Glen M"},
+					},
+					UnclaimedTeamIDs: []string{"DYFC", "SJRFC"},
+				},
+			},
+		},
+		{
+			name:           "sweepstake with invalid unclaimed teams must produce the expected error",
+			tournaments:    defaultTestTournaments,
+			configFilename: "sweepstakes_invalid_unclaimed_teams.json",
+			wantErr: newMultiError([]string{
+				"unclaimed team id 'DYFC': is duplicate",
+				"unclaimed team id 'PTFC': is claimed by a participant",
+				"unclaimed team id 'XYZ': not found",
+				"1 team has no participant: SJRFC",
+				"team id 'SJRFC': count 0",
+			}),
+		},
+		{
+			name:           "participant team ids with mismatched case must produce the expected error by default",
 			tournaments:    defaultTestTournaments,
+			configFilename: "sweepstakes_mismatched_case_team_ids.json",
+			wantErr: newMultiError([]string{
+				"participant index 0: unrecognised participant team id: abc",
+				"participant index 1: unrecognised participant team id: Def",
+				"2 teams have no participant: ABC, DEF",
+				"team id 'ABC': count 0",
+				"team id 'DEF': count 0",
+			}),
+		},
+		{
+			name:           "participant team ids with matching case must be loaded successfully with case-insensitive team ids",
+			tournaments:    domain.TournamentCollection{testTourney1, caseInsensitiveTestTourney2},
 			configFilename: "sweepstakes_ok.json",
 			wantSweepstakes: domain.SweepstakeCollection{
 				{
-					ID:         "test-sweepstake-1",
-					Name:       "Test Sweepstake 1",
-					Headline:   "Check out <a href=\"https://www.youtube.com/watch?v=dQw4w9WgXcQ\">this thing</a>!",
-					Tournament: testTourney1,
+					ID:          "test-sweepstake-1",
+					Name:        "Test Sweepstake 1",
+					Headline:    "Check out <a href=\"https://www.youtube.com/watch?v=dQw4w9WgXcQ\">this thing</a>!",
+					Description: "Welcome to the <b>sweepstake</b> - good luck!",
+					Tournament:  testTourney1,
 					Participants: []*domain.Participant{
 						{TeamID: "BPFC", Name: "John L"},
 						{TeamID: "DTFC", Name: "Paul M"},
@@ -272,7 +1992,7 @@ func TestSweepstakesJSONLoader_LoadSweepstakes(t *testing.T) {
 				{
 					ID:         "test-sweepstake-2",
 					Name:       "Test Sweepstake 2",
-					Tournament: testTourney2,
+					Tournament: caseInsensitiveTestTourney2,
 					Participants: []*domain.Participant{
 						{TeamID: "ABC", Name: "Dara"},
 						{TeamID: "DEF", Name: "Ed"},
@@ -282,54 +2002,83 @@ func TestSweepstakesJSONLoader_LoadSweepstakes(t *testing.T) {
 			},
 		},
 		{
-			name:    "empty tournaments must produce the expected error",
-			wantErr: domain.ErrIsEmpty,
-			// tournaments are empty
+			name:           "participant team ids with mismatched case must be normalised with case-insensitive team ids",
+			tournaments:    domain.TournamentCollection{testTourney1, caseInsensitiveTestTourney2},
+			configFilename: "sweepstakes_mismatched_case_team_ids.json",
+			wantSweepstakes: domain.SweepstakeCollection{
+				{
+					ID:         "test-sweepstake-2",
+					Name:       "Test Sweepstake 2",
+					Tournament: caseInsensitiveTestTourney2,
+					Participants: []*domain.Participant{
+						{TeamID: "ABC", Name: "Dara"}, // canonical id
+						{TeamID: "DEF", Name: "Ed"},   // canonical id
+					},
+				},
+			},
 		},
 		{
-			name:        "empty config filename must produce the expected error",
-			tournaments: defaultTestTournaments,
-			wantErr:     errors.New("cannot open file '': open : file does not exist"),
-			// configFilename is empty
+			name:           "participant team that is absent from matches must produce the expected error",
+			tournaments:    defaultTestTournaments,
+			configFilename: "sweepstakes_team_absent_from_matches.json",
+			wantErr: newMultiError([]string{
+				"participant index 2: team id 'GHI': picked by participant but absent from matches",
+			}),
 		},
 		{
-			name:           "non-existent config file must produce the expected error",
+			name:           "participant name with invalid utf-8 must produce the expected error",
 			tournaments:    defaultTestTournaments,
-			configFilename: "non-existent.json",
-			wantErr:        fs.ErrNotExist,
+			configFilename: "sweepstakes_invalid_utf8_participant_name.json",
+			wantErr: newMultiError([]string{
+				"participant index 0: name 'Dara\uFFFD\uFFFD': contains invalid utf-8",
+			}),
 		},
 		{
-			name:           "invalid sweepstake format must produce the expected error",
+			name:           "sweepstake with unknown and duplicate prize order keys must produce the expected error",
 			tournaments:    defaultTestTournaments,
-			configFilename: "sweepstakes_unmarshalable.json",
-			wantErr: fmt.Errorf("cannot unmarshal sweepstakes: %w", &json.UnmarshalTypeError{
-				Value: "number",
-				Type:  reflect.TypeOf("string"),
-				Field: "sweepstakes.id",
+			configFilename: "sweepstakes_invalid_prize_order.json",
+			wantErr: newMultiError([]string{
+				"prize order key 'golden_boot': not found",
+				"prize order key 'winner': is duplicate",
 			}),
 		},
 		{
-			name:           "no sweepstakes must produce the expected error",
+			name:           "sweepstake with unknown and empty prize weightings must produce the expected error",
 			tournaments:    defaultTestTournaments,
-			configFilename: "sweepstakes_none.json",
-			wantErr:        errors.New("no sweepstakes found in source data"),
+			configFilename: "sweepstakes_invalid_prize_weightings.json",
+			wantErr: newMultiError([]string{
+				"prize weighting key 'golden_boot': not found",
+				"prize weighting 'most_goals_conceded': is empty",
+				"prize weighting key 'overall_standings': not found",
+			}),
 		},
 		{
-			name:           "non-existent tournament id must produce the expected error",
+			name:           "sweepstake with unknown and inverted minute bounds must produce the expected error",
 			tournaments:    defaultTestTournaments,
-			configFilename: "sweepstakes_non_existent_tournament_id.json",
-			wantErr:        domain.ErrNotFound,
+			configFilename: "sweepstakes_invalid_minute_bounds.json",
+			wantErr: newMultiError([]string{
+				"minute bounds key 'most_assists': not found",
+				"minute bounds 'quickest_red_card': min 46 must not exceed max 45",
+			}),
 		},
 		{
-			name:           "invalid sweepstake must produce the expected error",
+			name:           "sweepstake with unknown and non-positive minimum values must produce the expected error",
 			tournaments:    defaultTestTournaments,
-			configFilename: "sweepstakes_invalid.json",
+			configFilename: "sweepstakes_invalid_minimum_values.json",
 			wantErr: newMultiError([]string{
-				"id: is empty",
-				"name: is empty",
-				"participant index 0: unrecognised participant team id: NOT_BPFC",
-				"team id 'BPFC': count 0",
-				"team id 'WTFC': count 2",
+				"minimum values 'most_assists': 0 must be positive",
+				"minimum values key 'overall': not found",
+				"minimum values key 'quickest_own_goal': not found",
+			}),
+		},
+		{
+			name:           "sweepstakes with invalid assignments must produce the expected error",
+			tournaments:    defaultTestTournaments,
+			configFilename: "sweepstakes_invalid_assignments.json",
+			wantErr: newMultiError([]string{
+				"participant index 0: assignment index 1: team id 'XYZ': not found",
+				"participant index 1: assignment index 0: team id 'DTFC' from 2018-05-27T14:00:00Z: is duplicate",
+				"participant index 1: assignment index 1: from: is empty",
 			}),
 		},
 		{
@@ -340,6 +2089,22 @@ func TestSweepstakesJSONLoader_LoadSweepstakes(t *testing.T) {
 				"id 'test-sweepstake-1': is duplicate",
 			}),
 		},
+		{
+			name:           "sweepstakes with colliding slugs must produce the expected error",
+			tournaments:    defaultTestTournaments,
+			configFilename: "sweepstakes_slug_collision.json",
+			wantErr: newMultiError([]string{
+				"id 'test-sweepstake-1': slug 'test-sweepstake-1': is duplicate",
+			}),
+		},
+		{
+			name:           "sweepstake with id that produces an empty slug must produce the expected error",
+			tournaments:    defaultTestTournaments,
+			configFilename: "sweepstakes_empty_slug.json",
+			wantErr: newMultiError([]string{
+				"id '!!!': slug: is empty",
+			}),
+		},
 	}
 
 	for _, tc := range tt {
@@ -356,6 +2121,326 @@ func TestSweepstakesJSONLoader_LoadSweepstakes(t *testing.T) {
 	}
 }
 
+func TestSweepstakesJSONLoader_LoadSweepstakes_WithValidationOptions(t *testing.T) {
+	tournaments := domain.TournamentCollection{
+		{
+			ID: "TestTourney1",
+			Teams: domain.TeamCollection{
+				{ID: "BPFC"},
+				{ID: "DTFC"},
+				{ID: "DYFC"},
+				{ID: "HUFC"},
+				{ID: "PTFC"},
+				{ID: "SJRFC"},
+				{ID: "STHFC"},
+				{ID: "WTFC"},
+			},
+		},
+	}
+
+	opts := domain.ValidationOptions{
+		Prefix:        "sweepstakes",
+		IsEmptyLabel:  "est vide",
+		NotFoundLabel: "introuvable",
+	}
+
+	tt := []struct {
+		name           string
+		configFilename string
+		wantErr        error
+	}{
+		{
+			name:           "non-existent tournament id must produce the expected error",
+			configFilename: "sweepstakes_non_existent_tournament_id.json",
+			wantErr:        errors.New("sweepstakes: sweepstake index 0: tournament id 'non-existent-tourney-id': introuvable"),
+		},
+		{
+			name:           "invalid sweepstake must produce the expected error",
+			configFilename: "sweepstakes_invalid.json",
+			wantErr: errors.New("sweepstakes: " + newMultiError([]string{
+				"id: est vide",
+				"name: est vide",
+				"participant index 0: unrecognised participant team id: NOT_BPFC",
+				"1 team has no participant: BPFC",
+				"1 team has multiple participants: WTFC",
+				"team id 'BPFC': count 0",
+				"team id 'WTFC': count 2",
+			}).Error()),
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			loader := newSweepstakesJSONLoader(tc.configFilename).
+				WithTournamentCollection(tournaments).
+				WithValidationOptions(opts)
+
+			gotSweepstakes, gotErr := loader.LoadSweepstakes(ctx)
+			cmpError(t, tc.wantErr, gotErr)
+			cmpDiff(t, domain.SweepstakeCollection(nil), gotSweepstakes)
+		})
+	}
+}
+
+func TestSweepstakesJSONLoader_LoadSweepstakes_WithSchemaValidation(t *testing.T) {
+	tournaments := domain.TournamentCollection{
+		{
+			ID: "TestTourney2",
+			Teams: domain.TeamCollection{
+				{ID: "ABC"},
+				{ID: "DEF"},
+			},
+		},
+	}
+
+	tt := []struct {
+		name             string
+		configFilename   string
+		schemaValidation bool
+		wantErr          error
+	}{
+		{
+			name:             "schema-violating config must produce an error for each violation",
+			configFilename:   "sweepstakes_schema_violation.json",
+			schemaValidation: true,
+			wantErr: newMultiError([]string{
+				"sweepstakes[0].build: expected boolean, but got string",
+				"sweepstakes[0].id: expected string, but got number",
+				"sweepstakes[0].name: is required",
+				"sweepstakes[0].participants[1].team_id: is required",
+			}),
+		},
+		{
+			name:             "schema-violating config must produce a generic unmarshal error without schema validation",
+			configFilename:   "sweepstakes_schema_violation.json",
+			schemaValidation: false,
+			wantErr: fmt.Errorf("cannot unmarshal sweepstakes: %w", &json.UnmarshalTypeError{
+				Value: "number",
+				Type:  reflect.TypeOf("string"),
+				Field: jsonFieldPath("sweepstakes", "0", "id"),
+			}),
+		},
+		{
+			name:             "config without sweepstakes envelope must produce the expected error",
+			configFilename:   "sweepstakes_schema_missing_envelope.json",
+			schemaValidation: true,
+			wantErr: newMultiError([]string{
+				"sweepstakes: is required",
+			}),
+		},
+		{
+			name:             "valid config must not produce a schema error",
+			configFilename:   "sweepstakes_none.json",
+			schemaValidation: true,
+			wantErr:          errors.New("no sweepstakes found in source data"),
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			loader := newSweepstakesJSONLoader(tc.configFilename).
+				WithTournamentCollection(tournaments).
+				WithSchemaValidation(tc.schemaValidation)
+
+			_, gotErr := loader.LoadSweepstakes(ctx)
+			cmpError(t, tc.wantErr, gotErr)
+		})
+	}
+}
+
+func TestSweepstakesJSONLoader_LoadSweepstakes_WithSanitisedNames(t *testing.T) {
+	testTourney2 := &domain.Tournament{
+		ID: "TestTourney2",
+		Teams: domain.TeamCollection{
+			{ID: "ABC"},
+			{ID: "DEF"},
+		},
+	}
+
+	ctx := context.Background()
+
+	loader := newSweepstakesJSONLoader("sweepstakes_invalid_utf8_participant_name.json").
+		WithTournamentCollection(domain.TournamentCollection{testTourney2}).
+		WithSanitisedNames(true)
+
+	gotSweepstakes, gotErr := loader.LoadSweepstakes(ctx)
+	cmpError(t, nil, gotErr)
+	cmpDiff(t, domain.SweepstakeCollection{
+		{
+			ID:         "test-sweepstake-2",
+			Name:       "Test Sweepstake 2",
+			Tournament: testTourney2,
+			Participants: []*domain.Participant{
+				{TeamID: "ABC", Name: "Dara"}, // invalid utf-8 removed
+				{TeamID: "DEF", Name: "Ed"},
+			},
+		},
+	}, gotSweepstakes)
+}
+
+func TestSweepstakesJSONLoader_LoadSweepstakes_WithEnabledPrizes(t *testing.T) {
+	testTourney2 := &domain.Tournament{
+		ID: "TestTourney2",
+		Teams: domain.TeamCollection{
+			{ID: "ABC"},
+			{ID: "DEF"},
+		},
+	}
+
+	tt := []struct {
+		name              string
+		enabledPrizes     []string
+		wantEnabledPrizes []string
+		wantErr           error
+	}{
+		{
+			name:              "enabled prizes must be set on each sweepstake",
+			enabledPrizes:     []string{"winner", " wooden_spoon ", ""},
+			wantEnabledPrizes: []string{"winner", "wooden_spoon"},
+		},
+		{
+			name: "no enabled prizes must leave each sweepstake without enabled prizes",
+			// want no enabled prizes
+		},
+		{
+			name:          "unknown enabled prizes must produce the expected error",
+			enabledPrizes: []string{"winner", "most_goals_scored", "golden_boot"},
+			wantErr: newMultiError([]string{
+				"enabled prize key 'most_goals_scored': not found",
+				"enabled prize key 'golden_boot': not found",
+			}),
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			loader := newSweepstakesJSONLoader("sweepstakes_invalid_utf8_participant_name.json").
+				WithTournamentCollection(domain.TournamentCollection{testTourney2}).
+				WithSanitisedNames(true).
+				WithEnabledPrizes(tc.enabledPrizes)
+
+			gotSweepstakes, gotErr := loader.LoadSweepstakes(context.Background())
+			cmpError(t, tc.wantErr, gotErr)
+
+			for _, sweepstake := range gotSweepstakes {
+				cmpDiff(t, tc.wantEnabledPrizes, sweepstake.EnabledPrizes)
+			}
+		})
+	}
+}
+
+func TestSweepstakesJSONLoader_LoadSweepstakes_WithTranslations(t *testing.T) {
+	testTourney2 := &domain.Tournament{
+		ID: "TestTourney2",
+		Teams: domain.TeamCollection{
+			{ID: "ABC"},
+			{ID: "DEF"},
+		},
+	}
+
+	translations := domain.Translations{
+		"fr": {"TBC": "À confirmer"},
+	}
+
+	tt := []struct {
+		name         string
+		translations domain.Translations
+		wantLang     string
+		wantErr      error
+	}{
+		{
+			name:         "sweepstake with translated language must be loaded successfully",
+			translations: translations,
+			wantLang:     "fr",
+		},
+		{
+			name:    "sweepstake with language that has no translations must produce the expected error",
+			wantErr: newMultiError([]string{"lang 'fr': not found"}),
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			loader := newSweepstakesJSONLoader("sweepstakes_with_lang.json").
+				WithTournamentCollection(domain.TournamentCollection{testTourney2}).
+				WithTranslations(tc.translations)
+
+			gotSweepstakes, gotErr := loader.LoadSweepstakes(context.Background())
+			cmpError(t, tc.wantErr, gotErr)
+
+			for _, sweepstake := range gotSweepstakes {
+				cmpDiff(t, tc.wantLang, sweepstake.Lang)
+				cmpDiff(t, tc.translations, sweepstake.Translations)
+			}
+		})
+	}
+}
+
+func TestSweepstakesJSONLoader_LoadSweepstakesWithReport(t *testing.T) {
+	tournaments := domain.TournamentCollection{
+		{
+			ID: "TestTourney1",
+			Teams: domain.TeamCollection{
+				{ID: "BPFC"},
+				{ID: "DTFC"},
+				{ID: "DYFC"},
+				{ID: "HUFC"},
+				{ID: "PTFC"},
+				{ID: "SJRFC"},
+				{ID: "STHFC"},
+				{ID: "WTFC"},
+			},
+		},
+		{
+			ID: "TestTourney2",
+			Teams: domain.TeamCollection{
+				{ID: "ABC"},
+				{ID: "DEF"},
+			},
+		},
+	}
+
+	tt := []struct {
+		name           string
+		configFilename string
+		wantReport     domain.SweepstakesReport
+		wantCount      int
+		wantErr        error
+	}{
+		{
+			name:           "valid sweepstake json must produce the expected report",
+			configFilename: "sweepstakes_ok.json",
+			wantReport: domain.SweepstakesReport{
+				Sweepstakes:  2,
+				Participants: 10,
+				Tournaments:  2,
+			},
+			wantCount: 2,
+		},
+		{
+			name:           "non-existent tournament id must produce the expected error and empty report",
+			configFilename: "sweepstakes_non_existent_tournament_id.json",
+			wantErr:        errors.New("sweepstake index 0: tournament id 'non-existent-tourney-id': not found"),
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			loader := newSweepstakesJSONLoader(tc.configFilename).
+				WithTournamentCollection(tournaments)
+
+			gotSweepstakes, gotReport, gotErr := loader.LoadSweepstakesWithReport(context.Background())
+			cmpError(t, tc.wantErr, gotErr)
+			cmpDiff(t, tc.wantReport, gotReport)
+			cmpDiff(t, tc.wantCount, len(gotSweepstakes))
+		})
+	}
+}
+
 func newSweepstakesJSONLoader(path string) *domain.SweepstakesJSONLoader {
 	if path != "" {
 		path = filepath.Join(testdataDir, sweepstakesDir, path)
@@ -396,6 +2481,42 @@ var templateComparer = cmp.Comparer(func(want, got *template.Template) bool {
 	return wantBuf.String() == gotBuf.String()
 })
 
+// jsonFieldPath returns the field path reported by a json unmarshal error for the provided elements
+//
+// Array indices (numeric elements) are only reported by later versions of encoding/json
+func jsonFieldPath(elems ...string) string {
+	var probe struct {
+		A []string `json:"a"`
+	}
+
+	var typeErr *json.UnmarshalTypeError
+	if errors.As(json.Unmarshal([]byte(`{"a":[1]}`), &probe), &typeErr) && typeErr.Field == "a" {
+		var filtered []string
+		for _, elem := range elems {
+			if _, err := strconv.Atoi(elem); err != nil {
+				filtered = append(filtered, elem)
+			}
+		}
+		elems = filtered
+	}
+
+	return strings.Join(elems, ".")
+}
+
+var locationComparer = cmp.Comparer(func(want, got *time.Location) bool {
+	// want and got are equal locations if they have the same name
+	switch {
+	case want == nil || got == nil:
+		return want == got
+	default:
+		return want.String() == got.String()
+	}
+})
+
+// tournamentCacheIgnorer ignores the audits and parsed value templates that a tournament caches while generating its prizes,
+// the warnings that a sweepstake collects while it is rendered, and the winning team that each prize retains internally
+var tournamentCacheIgnorer = cmpopts.IgnoreUnexported(domain.Tournament{}, domain.Sweepstake{}, domain.OutrightPrize{}, domain.Rank{})
+
 func readTestDataFile(t *testing.T, path ...string) []byte {
 	t.Helper()
 	path = append([]string{"testdata"}, path...)
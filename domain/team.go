@@ -3,12 +3,15 @@ package domain
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"io/fs"
+	"net/url"
 	"sort"
 	"strings"
 	"sync"
+	"unicode/utf8"
 )
 
 var (
@@ -34,9 +37,103 @@ func (tc TeamCollection) GetByID(id string) *Team {
 	return nil
 }
 
+// GetByIDFold returns the team whose id matches the provided id regardless of case, preferring an exact match, or nil if no team matches
+func (tc TeamCollection) GetByIDFold(id string) *Team {
+	if team := tc.GetByID(id); team != nil {
+		return team
+	}
+
+	for _, team := range tc {
+		if team != nil && strings.EqualFold(team.ID, id) {
+			return team
+		}
+	}
+
+	return nil
+}
+
+// NormaliseImages resolves each team's relative image url against the provided base url, and ensures that each absolute image url is a valid http(s) url
+//
+// A team without an image url is left as-is (see TeamsJSONLoader.WithMissingImageWarnings). If baseURL is empty, a relative image url is invalid.
+// The image urls that remain invalid are returned as a MultiError, and are not modified
+func (tc TeamCollection) NormaliseImages(baseURL string) error {
+	var base *url.URL
+	if baseURL != "" {
+		parsed, err := parseImageURL(baseURL)
+		if err != nil {
+			return fmt.Errorf("base url '%s': %w", baseURL, err)
+		}
+		base = parsed
+	}
+
+	mErr := NewMultiError()
+
+	for idx, team := range tc {
+		if team == nil {
+			continue
+		}
+
+		imageURL := strings.Trim(team.ImageURL, " ")
+		if imageURL == "" {
+			continue
+		}
+
+		mErrTeam := mErr.WithPrefix(fmt.Sprintf("team id '%s'", team.ID))
+
+		ref, err := url.Parse(imageURL)
+		if err != nil {
+			mErrTeam.Add(fmt.Errorf("image url '%s': cannot parse", imageURL))
+			continue
+		}
+
+		if !ref.IsAbs() {
+			if base == nil {
+				mErrTeam.Add(fmt.Errorf("image url '%s': is relative without a base url", imageURL))
+				continue
+			}
+			ref = base.ResolveReference(ref)
+		}
+
+		resolved, err := parseImageURL(ref.String())
+		if err != nil {
+			mErrTeam.Add(fmt.Errorf("image url '%s': %w", imageURL, err))
+			continue
+		}
+
+		tc[idx].ImageURL = resolved.String()
+	}
+
+	if !mErr.IsEmpty() {
+		return mErr
+	}
+
+	return nil
+}
+
+// parseImageURL returns the provided url if it is an absolute http(s) url with a host, or an error otherwise
+func parseImageURL(raw string) (*url.URL, error) {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return nil, errors.New("cannot parse")
+	}
+
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("scheme '%s' must be http or https", u.Scheme)
+	}
+
+	if u.Host == "" {
+		return nil, fmt.Errorf("host: %w", ErrIsEmpty)
+	}
+
+	return u, nil
+}
+
 type TeamsJSONLoader struct {
-	fSys fs.FS
-	path string
+	fSys           fs.FS
+	path           string
+	warnings       MultiError
+	sanitiseNames  bool
+	validationOpts ValidationOptions
 }
 
 func (t *TeamsJSONLoader) WithFileSystem(fSys fs.FS) *TeamsJSONLoader {
@@ -49,6 +146,28 @@ func (t *TeamsJSONLoader) WithPath(path string) *TeamsJSONLoader {
 	return t
 }
 
+// WithMissingImageWarnings records a team with an empty image url as a warning within the provided collector, rather than failing validation
+//
+// If warnings is empty (nil), a team with an empty image url fails validation (default)
+func (t *TeamsJSONLoader) WithMissingImageWarnings(warnings MultiError) *TeamsJSONLoader {
+	t.warnings = warnings
+	return t
+}
+
+// WithSanitisedNames determines whether invalid utf-8 is removed from each team name, rather than failing validation
+//
+// If sanitise is false, a team name that contains invalid utf-8 (e.g. from a mis-encoded file) fails validation (default)
+func (t *TeamsJSONLoader) WithSanitisedNames(sanitise bool) *TeamsJSONLoader {
+	t.sanitiseNames = sanitise
+	return t
+}
+
+// WithValidationOptions customises the messages of the errors that are returned when loading teams
+func (t *TeamsJSONLoader) WithValidationOptions(opts ValidationOptions) *TeamsJSONLoader {
+	t.validationOpts = opts
+	return t
+}
+
 func (t *TeamsJSONLoader) init() error {
 	if t.fSys == nil {
 		t.fSys = defaultFileSystem
@@ -61,7 +180,16 @@ func (t *TeamsJSONLoader) init() error {
 	return nil
 }
 
-func (t *TeamsJSONLoader) LoadTeams(_ context.Context) (TeamCollection, error) {
+func (t *TeamsJSONLoader) LoadTeams(ctx context.Context) (TeamCollection, error) {
+	teams, err := t.loadTeams(ctx)
+	if err != nil {
+		return nil, t.validationOpts.apply(err)
+	}
+
+	return teams, nil
+}
+
+func (t *TeamsJSONLoader) loadTeams(_ context.Context) (TeamCollection, error) {
 	if err := t.init(); err != nil {
 		return nil, err
 	}
@@ -80,7 +208,7 @@ func (t *TeamsJSONLoader) LoadTeams(_ context.Context) (TeamCollection, error) {
 		return nil, fmt.Errorf("cannot unmarshal team collection: %w", err)
 	}
 
-	return validateTeams(content.Teams)
+	return validateTeams(content.Teams, t.warnings, t.sanitiseNames)
 }
 
 func readFile(fSys fs.FS, path string) ([]byte, error) {
@@ -100,12 +228,17 @@ func readFile(fSys fs.FS, path string) ([]byte, error) {
 	return b, nil
 }
 
-func validateTeams(teams TeamCollection) (TeamCollection, error) {
+func validateTeams(teams TeamCollection, warnings MultiError, sanitiseNames bool) (TeamCollection, error) {
 	ids := &sync.Map{}
 
 	for idx, team := range teams {
+		var warningsIdx MultiError
+		if warnings != nil {
+			warningsIdx = warnings.WithPrefix(fmt.Sprintf("team at index %d", idx))
+		}
+
 		// validate current team
-		if err := validateTeam(team); err != nil {
+		if err := validateTeam(team, warningsIdx, sanitiseNames); err != nil {
 			return nil, fmt.Errorf("invalid team at index %d: %w", idx, err)
 		}
 
@@ -119,7 +252,7 @@ func validateTeams(teams TeamCollection) (TeamCollection, error) {
 	return teams, nil
 }
 
-func validateTeam(team *Team) error {
+func validateTeam(team *Team, warnings MultiError, sanitiseNames bool) error {
 	team.ID = strings.Trim(team.ID, " ")
 	team.Name = strings.Trim(team.Name, " ")
 	team.ImageURL = strings.Trim(team.ImageURL, " ")
@@ -128,17 +261,44 @@ func validateTeam(team *Team) error {
 		return fmt.Errorf("id: %w", ErrIsEmpty)
 	}
 
+	name, err := validateUTF8(team.Name, sanitiseNames)
+	if err != nil {
+		return fmt.Errorf("name '%s': %w", team.Name, err)
+	}
+	team.Name = name
+
 	if team.Name == "" {
 		return fmt.Errorf("name: %w", ErrIsEmpty)
 	}
 
 	if team.ImageURL == "" {
-		return fmt.Errorf("image url: %w", ErrIsEmpty)
+		err := fmt.Errorf("image url: %w", ErrIsEmpty)
+		if warnings == nil {
+			return err
+		}
+		warnings.Add(err)
 	}
 
 	return nil
 }
 
+// validateUTF8 returns the provided value if it only contains valid utf-8, or an error otherwise
+//
+// The utf-8 replacement character is also considered to be invalid, since it is substituted for invalid bytes when json is unmarshalled.
+// If sanitise is true, invalid utf-8 is removed from the value instead of returning an error
+func validateUTF8(value string, sanitise bool) (string, error) {
+	if utf8.ValidString(value) && !strings.ContainsRune(value, utf8.RuneError) {
+		return value, nil
+	}
+
+	if !sanitise {
+		return "", ErrInvalidUTF8
+	}
+
+	sanitised := strings.ReplaceAll(strings.ToValidUTF8(value, ""), string(utf8.RuneError), "")
+	return strings.Trim(sanitised, " "), nil
+}
+
 type teamsAudit struct {
 	teams TeamCollection
 	mp    *sync.Map
@@ -197,6 +357,26 @@ func (t *teamsAudit) ack(team *Team) bool {
 	return t.inc(team, 1)
 }
 
+// coverage returns the sorted ids of the teams that have not been counted, and the teams that have been counted more than once
+func (t *teamsAudit) coverage() (missing, multiple []string) {
+	t.init()
+
+	t.mp.Range(func(key, val any) bool {
+		switch {
+		case val.(int) == 0:
+			missing = append(missing, key.(string))
+		case val.(int) > 1:
+			multiple = append(multiple, key.(string))
+		}
+		return true
+	})
+
+	sort.Strings(missing)
+	sort.Strings(multiple)
+
+	return missing, multiple
+}
+
 func (t *teamsAudit) validate(mErr MultiError, exactlyOnce bool) {
 	t.init()
 
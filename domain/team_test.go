@@ -15,11 +15,12 @@ import (
 )
 
 const (
-	matchesDir     = "matches"
-	sweepstakesDir = "sweepstakes"
-	teamsDir       = "teams"
-	testdataDir    = "testdata"
-	tournamentsDir = "tournaments"
+	matchesDir      = "matches"
+	sweepstakesDir  = "sweepstakes"
+	teamsDir        = "teams"
+	translationsDir = "translations"
+	testdataDir     = "testdata"
+	tournamentsDir  = "tournaments"
 )
 
 var (
@@ -82,6 +83,161 @@ func TestTeamCollection_GetByID(t *testing.T) {
 	}
 }
 
+func TestTeamCollection_GetByIDFold(t *testing.T) {
+	teamA := &domain.Team{
+		ID:       "teamA",
+		Name:     "TeamA",
+		ImageURL: "http://team-a.jpg",
+	}
+
+	teamB1 := &domain.Team{
+		ID:       "TEAMB",
+		Name:     "TeamB1",
+		ImageURL: "http://team-b1.jpg",
+	}
+
+	teamB2 := &domain.Team{
+		ID:       "teamB",
+		Name:     "TeamB2",
+		ImageURL: "http://team-b2.jpg",
+	}
+
+	collection := domain.TeamCollection{
+		teamA,
+		teamB1,
+		teamB2, // differs from teamB1 only by case, so should only be returned by an exact match
+	}
+
+	tt := []struct {
+		name     string
+		id       string
+		wantTeam *domain.Team
+	}{
+		{
+			name:     "team id with matching case must return matching item",
+			id:       "teamA",
+			wantTeam: teamA,
+		},
+		{
+			name:     "team id with mismatched case must return matching item",
+			id:       "TEAMa",
+			wantTeam: teamA,
+		},
+		{
+			name:     "team id with exact match must be preferred over an earlier case-insensitive match",
+			id:       "teamB",
+			wantTeam: teamB2,
+		},
+		{
+			name:     "team id with mismatched case must return first case-insensitive match",
+			id:       "TeamB",
+			wantTeam: teamB1,
+		},
+		{
+			name: "non-matching item must return nil",
+			id:   "teamC",
+			// want nil team
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			gotTeam := collection.GetByIDFold(tc.id)
+			cmpDiff(t, tc.wantTeam, gotTeam)
+		})
+	}
+}
+
+func TestTeamCollection_NormaliseImages(t *testing.T) {
+	newTeams := func() domain.TeamCollection {
+		return domain.TeamCollection{
+			{ID: "ABS", Name: "Absolute", ImageURL: "https://example.com/abs.png"},
+			{ID: "REL", Name: "Relative", ImageURL: "img/rel.png"},
+			{ID: "ROOT", Name: "Root-Relative", ImageURL: " /rel.png "},
+			{ID: "PROTO", Name: "Protocol-Relative", ImageURL: "//cdn.example.com/proto.png"},
+			{ID: "NONE", Name: "No Image"},
+		}
+	}
+
+	tt := []struct {
+		name       string
+		teams      domain.TeamCollection
+		baseURL    string
+		wantImages []string
+		wantErr    error
+	}{
+		{
+			name:    "relative image urls must be resolved against base url",
+			teams:   newTeams(),
+			baseURL: "https://static.example.com/teams/",
+			wantImages: []string{
+				"https://example.com/abs.png",
+				"https://static.example.com/teams/img/rel.png",
+				"https://static.example.com/rel.png",
+				"https://cdn.example.com/proto.png",
+				"",
+			},
+		},
+		{
+			name:  "relative image urls without base url must produce the expected error and remain unmodified",
+			teams: newTeams(),
+			wantImages: []string{
+				"https://example.com/abs.png",
+				"img/rel.png",
+				" /rel.png ",
+				"//cdn.example.com/proto.png",
+				"",
+			},
+			wantErr: newMultiError([]string{
+				"team id 'REL': image url 'img/rel.png': is relative without a base url",
+				"team id 'ROOT': image url '/rel.png': is relative without a base url",
+				"team id 'PROTO': image url '//cdn.example.com/proto.png': is relative without a base url",
+			}),
+		},
+		{
+			name: "invalid absolute image urls must produce the expected error and remain unmodified",
+			teams: domain.TeamCollection{
+				{ID: "FTP", Name: "FTP", ImageURL: "ftp://example.com/ftp.png"},
+				{ID: "NOHOST", Name: "No Host", ImageURL: "https:///nohost.png"},
+				{ID: "BAD", Name: "Unparseable", ImageURL: "https://exa mple.com/%zz.png"},
+				{ID: "OK", Name: "Valid", ImageURL: "rel.png"},
+			},
+			baseURL: "http://example.com",
+			wantImages: []string{
+				"ftp://example.com/ftp.png",
+				"https:///nohost.png",
+				"https://exa mple.com/%zz.png",
+				"http://example.com/rel.png",
+			},
+			wantErr: newMultiError([]string{
+				"team id 'FTP': image url 'ftp://example.com/ftp.png': scheme 'ftp' must be http or https",
+				"team id 'NOHOST': image url 'https:///nohost.png': host: is empty",
+				"team id 'BAD': image url 'https://exa mple.com/%zz.png': cannot parse",
+			}),
+		},
+		{
+			name:       "relative base url must produce the expected error",
+			teams:      domain.TeamCollection{{ID: "REL", Name: "Relative", ImageURL: "rel.png"}},
+			baseURL:    "/static/",
+			wantImages: []string{"rel.png"},
+			wantErr:    errors.New("base url '/static/': scheme '' must be http or https"),
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			gotErr := tc.teams.NormaliseImages(tc.baseURL)
+			cmpError(t, tc.wantErr, gotErr)
+
+			var gotImages []string
+			for _, team := range tc.teams {
+				gotImages = append(gotImages, team.ImageURL)
+			}
+			cmpDiff(t, tc.wantImages, gotImages)
+		})
+	}
+}
+
 func TestTeamsJSONLoader_LoadTeams(t *testing.T) {
 	tt := []struct {
 		name      string
@@ -137,6 +293,11 @@ func TestTeamsJSONLoader_LoadTeams(t *testing.T) {
 			testFile: "teams_empty_image_url.json",
 			wantErr:  errors.New("invalid team at index 0: image url: is empty"),
 		},
+		{
+			name:     "team name with invalid utf-8 must produce the expected error",
+			testFile: "teams_invalid_utf8_name.json",
+			wantErr:  fmt.Errorf("invalid team at index 0: name 'Poole Town\uFFFD': %w", domain.ErrInvalidUTF8),
+		},
 		{
 			name:     "duplicate team id must produce the expected error",
 			testFile: "teams_duplicate_id.json",
@@ -155,6 +316,151 @@ func TestTeamsJSONLoader_LoadTeams(t *testing.T) {
 	}
 }
 
+func TestTeamsJSONLoader_LoadTeams_WithMissingImageWarnings(t *testing.T) {
+	tt := []struct {
+		name         string
+		testFile     string
+		wantTeams    domain.TeamCollection
+		wantErr      error
+		wantWarnings error
+	}{
+		{
+			name:         "empty team image url must be recorded as a warning",
+			testFile:     "teams_empty_image_url.json",
+			wantTeams:    domain.TeamCollection{{ID: "PTFC", Name: "Poole Town"}},
+			wantWarnings: newMultiError([]string{"team at index 0: image url: is empty"}),
+		},
+		{
+			name:         "empty team name must still produce the expected error",
+			testFile:     "teams_empty_name.json",
+			wantErr:      errors.New("invalid team at index 0: name: is empty"),
+			wantWarnings: newMultiError(nil),
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			warnings := domain.NewMultiError()
+
+			loader := newTeamsJSONLoader(tc.testFile).WithMissingImageWarnings(warnings)
+			gotTeams, gotErr := loader.LoadTeams(nil)
+
+			cmpError(t, tc.wantErr, gotErr)
+			cmpDiff(t, tc.wantTeams, gotTeams)
+			cmpError(t, tc.wantWarnings, warnings)
+		})
+	}
+}
+
+func TestTeamsJSONLoader_LoadTeams_WithSanitisedNames(t *testing.T) {
+	tt := []struct {
+		name      string
+		testFile  string
+		sanitise  bool
+		wantTeams domain.TeamCollection
+		wantErr   error
+	}{
+		{
+			name:     "team name with invalid utf-8 must produce the expected error if not sanitised",
+			testFile: "teams_invalid_utf8_name.json",
+			wantErr:  fmt.Errorf("invalid team at index 0: name 'Poole Town\uFFFD': %w", domain.ErrInvalidUTF8),
+		},
+		{
+			name:     "team name with invalid utf-8 must be sanitised",
+			testFile: "teams_invalid_utf8_name.json",
+			sanitise: true,
+			wantTeams: domain.TeamCollection{
+				{ID: "PTFC", Name: "Poole Town", ImageURL: "http://ptfc.jpg"},
+				{ID: "WTFC", Name: "Wimborne Town", ImageURL: "http://wtfc.jpg"},
+			},
+		},
+		{
+			name:     "team name with valid utf-8 must be unchanged if sanitised",
+			testFile: "teams_ok.json",
+			sanitise: true,
+			wantTeams: domain.TeamCollection{
+				{ID: "BPFC", Name: "Bournemouth Poppies", ImageURL: "http://bpfc.jpg"},
+				{ID: "DTFC", Name: "Dorchester Town", ImageURL: "http://dtfc.jpg"},
+				{ID: "DYFC", Name: "Dexters Youth", ImageURL: "http://dyfc.jpg"},
+				{ID: "HUFC", Name: "Hamworthy United", ImageURL: "http://hufc.jpg"},
+				{ID: "PTFC", Name: "Poole Town", ImageURL: "http://ptfc.jpg"},
+				{ID: "SJRFC", Name: "St John's Rangers", ImageURL: "http://sjrfc.jpg"},
+				{ID: "STHFC", Name: "Swanage Town & Herston", ImageURL: "http://sthfc.jpg"},
+				{ID: "WTFC", Name: "Wimborne Town", ImageURL: "http://wtfc.jpg"},
+			},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			loader := newTeamsJSONLoader(tc.testFile).WithSanitisedNames(tc.sanitise)
+			gotTeams, gotErr := loader.LoadTeams(nil)
+
+			cmpError(t, tc.wantErr, gotErr)
+			cmpDiff(t, tc.wantTeams, gotTeams)
+		})
+	}
+}
+
+func TestTeamsJSONLoader_LoadTeams_WithValidationOptions(t *testing.T) {
+	tt := []struct {
+		name     string
+		testFile string
+		opts     domain.ValidationOptions
+		wantErr  error
+	}{
+		{
+			name:     "default options must produce the default error message",
+			testFile: "teams_empty_id.json",
+			wantErr:  errors.New("invalid team at index 0: id: is empty"),
+		},
+		{
+			name:     "custom prefix must be prepended to the error message",
+			testFile: "teams_empty_id.json",
+			opts:     domain.ValidationOptions{Prefix: "teams.json"},
+			wantErr:  errors.New("teams.json: invalid team at index 0: id: is empty"),
+		},
+		{
+			name:     "custom prefix and is empty label must produce the expected error message",
+			testFile: "teams_empty_name.json",
+			opts:     domain.ValidationOptions{Prefix: "teams.json", IsEmptyLabel: "est vide"},
+			wantErr:  errors.New("teams.json: invalid team at index 0: name: est vide"),
+		},
+		{
+			name:     "is empty label must be used literally",
+			testFile: "teams_empty_name.json",
+			opts:     domain.ValidationOptions{IsEmptyLabel: "${1} vide"},
+			wantErr:  errors.New("invalid team at index 0: name: ${1} vide"),
+		},
+		{
+			name:     "custom not found label must not affect other errors",
+			testFile: "teams_duplicate_id.json",
+			opts:     domain.ValidationOptions{NotFoundLabel: "introuvable"},
+			wantErr:  errors.New("invalid team at index 2: id PTFC: is duplicate"),
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			loader := newTeamsJSONLoader(tc.testFile).WithValidationOptions(tc.opts)
+			gotTeams, gotErr := loader.LoadTeams(nil)
+
+			cmpError(t, tc.wantErr, gotErr)
+			cmpDiff(t, domain.TeamCollection(nil), gotTeams)
+		})
+	}
+
+	t.Run("custom label must retain the underlying error", func(t *testing.T) {
+		opts := domain.ValidationOptions{IsEmptyLabel: "est vide"}
+		loader := newTeamsJSONLoader("teams_empty_id.json").WithValidationOptions(opts)
+
+		_, gotErr := loader.LoadTeams(nil)
+		if !errors.Is(gotErr, domain.ErrIsEmpty) {
+			t.Fatalf("want error '%s' to wrap '%s'", gotErr, domain.ErrIsEmpty)
+		}
+	})
+}
+
 func newTeamsJSONLoader(path string) *domain.TeamsJSONLoader {
 	if path != "" {
 		path = filepath.Join(testdataDir, teamsDir, path)
@@ -167,7 +473,7 @@ func newTeamsJSONLoader(path string) *domain.TeamsJSONLoader {
 
 func cmpDiff(t *testing.T, want, got interface{}) {
 	t.Helper()
-	if diff := cmp.Diff(want, got, templateComparer); diff != "" {
+	if diff := cmp.Diff(want, got, templateComparer, locationComparer, tournamentCacheIgnorer); diff != "" {
 		t.Fatalf("mismatch (-want, +got): %s", diff)
 	}
 }
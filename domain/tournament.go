@@ -1,13 +1,17 @@
 package domain
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"html/template"
 	"io/fs"
+	"path"
 	"regexp"
 	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -16,14 +20,292 @@ import (
 // rx provides a regex pattern matcher that targets the content between a set of [ ] square brackets
 var rx = regexp.MustCompile(`(\[.*\])+`)
 
+// verbRx provides a regex pattern matcher that targets each formatting verb within a format string (e.g. %s or %[2]s)
+var verbRx = regexp.MustCompile(`%(\[\d+\])?.`)
+
 type Tournament struct {
-	ID              string `json:"id"`
-	Name            string `json:"name"`
-	ImageURL        string `json:"image_url"`
-	Teams           TeamCollection
-	Matches         MatchCollection
-	Template        *template.Template
-	WithLastUpdated bool `json:"with_last_updated"`
+	ID                     string `json:"id"`
+	Name                   string `json:"name"`
+	ImageURL               string `json:"image_url"`
+	Teams                  TeamCollection
+	Matches                MatchCollection
+	Template               *template.Template
+	Variants               map[string]*template.Template
+	ParticipantTemplate    *template.Template
+	WithLastUpdated        bool              `json:"with_last_updated"`
+	SummaryFormat          string            `json:"summary_format"`
+	ValueTemplates         map[string]string `json:"value_templates"`
+	UnclaimedLabel         string            `json:"unclaimed_label"`
+	ValidateBracket        bool              `json:"validate_bracket"`
+	ValidateMarkup         bool              `json:"validate_markup"`
+	CaseInsensitiveTeamIDs bool              `json:"case_insensitive_team_ids"`
+	FinalMatchID           string            `json:"final_match_id"`
+	MatchesPerPage         int               `json:"matches_per_page"`
+	HighScoringGoals       int               `json:"high_scoring_goals"`
+	StaleMatchHours        int               `json:"stale_match_hours"`
+	Timezone               string            `json:"timezone"`
+	Location               *time.Location    `json:"-"`
+	Clock                  Clock             `json:"-"`
+
+	audits   map[string]teamsAudit // tallies of each ranked prize, keyed by prize key, cached when its prizes are generated
+	auditsMu sync.Mutex
+
+	valueTemplates     map[string]parsedValueTemplate // value templates, keyed by prize key, parsed once from ValueTemplates
+	valueTemplatesOnce sync.Once
+}
+
+// HasMarkupVariant returns true if the tournament has a markup variant with the provided name (e.g. "mobile")
+func (t *Tournament) HasMarkupVariant(name string) bool {
+	if t == nil {
+		return false
+	}
+
+	_, ok := t.Variants[name]
+	return ok
+}
+
+// FinalMatch returns the match with the tournament's final match id (default "F"), or nil if the tournament has no such match
+func (t *Tournament) FinalMatch() *Match {
+	if t == nil {
+		return nil
+	}
+
+	id := t.FinalMatchID
+	if id == "" {
+		id = defaultFinalMatchID
+	}
+
+	return t.Matches.GetByID(id)
+}
+
+// highScoringGoals returns the total goals at which a match is considered to be high-scoring (default 4)
+func (t *Tournament) highScoringGoals() int {
+	if t == nil || t.HighScoringGoals == 0 {
+		return defaultHighScoringGoals
+	}
+
+	return t.HighScoringGoals
+}
+
+// defaultStaleMatchHours defines the hours after kick-off at which a match that is not completed is considered to be stale, unless the tournament specifies otherwise
+const defaultStaleMatchHours = 3
+
+// HasStaleMatches returns the matches that are not completed, despite kicking off more than the tournament's stale match hours (default 3)
+// before the provided time, so that an operator can be warned that their results need to be entered
+//
+// If now is zero, the current time of the tournament's clock is used. A match without a timestamp is never stale
+func (t *Tournament) HasStaleMatches(now time.Time) []*Match {
+	if t == nil {
+		return nil
+	}
+
+	if now.IsZero() {
+		now = t.now()
+	}
+
+	hours := t.StaleMatchHours
+	if hours == 0 {
+		hours = defaultStaleMatchHours
+	}
+	cutoff := now.Add(-time.Duration(hours) * time.Hour)
+
+	var stale []*Match
+	for _, match := range t.Matches {
+		if match.Completed || match.Timestamp.IsZero() {
+			continue
+		}
+
+		if match.Timestamp.Before(cutoff) {
+			stale = append(stale, match)
+		}
+	}
+
+	return stale
+}
+
+// TotalAttendance returns the combined attendance of each of the tournament's matches
+func (t *Tournament) TotalAttendance() int {
+	if t == nil {
+		return 0
+	}
+
+	var total int
+	for _, match := range t.Matches {
+		total += match.Attendance
+	}
+
+	return total
+}
+
+// BestAttendedMatch returns the match with the highest attendance, or nil if no match has a known attendance
+//
+// Matches with an identical attendance are resolved in favour of the first match within the collection
+func (t *Tournament) BestAttendedMatch() *Match {
+	if t == nil {
+		return nil
+	}
+
+	var best *Match
+	for _, match := range t.Matches {
+		if match.Attendance > 0 && (best == nil || match.Attendance > best.Attendance) {
+			best = match
+		}
+	}
+
+	return best
+}
+
+// TopScoringTeam returns the team that has scored the most goals across the tournament's completed matches, or nil if no match is completed
+//
+// Teams with an identical number of goals are resolved in favour of the team that has conceded the fewest goals, then alphabetically by team name
+func (t *Tournament) TopScoringTeam() *Team {
+	if t == nil {
+		return nil
+	}
+
+	type record struct {
+		team     *Team
+		scored   int
+		conceded int
+	}
+
+	var records []*record
+	byID := make(map[string]*record)
+
+	for _, match := range t.Matches {
+		if !match.Completed {
+			continue
+		}
+
+		for _, competitors := range [][2]MatchCompetitor{{match.Home, match.Away}, {match.Away, match.Home}} {
+			competitor, opponent := competitors[0], competitors[1]
+			if competitor.Team == nil {
+				continue
+			}
+
+			r, ok := byID[competitor.Team.ID]
+			if !ok {
+				// prefer the tournament's own team over the match's copy
+				team := t.getTeamByID(competitor.Team.ID)
+				if team == nil {
+					team = competitor.Team
+				}
+
+				r = &record{team: team}
+				byID[competitor.Team.ID] = r
+				records = append(records, r)
+			}
+
+			r.scored += int(competitor.Goals)
+			r.conceded += int(opponent.Goals)
+		}
+	}
+
+	var top *record
+	for _, r := range records {
+		switch {
+		case top == nil,
+			r.scored > top.scored,
+			r.scored == top.scored && r.conceded < top.conceded,
+			r.scored == top.scored && r.conceded == top.conceded && r.team.Name < top.team.Name:
+			top = r
+		}
+	}
+
+	if top == nil {
+		return nil
+	}
+
+	return top.team
+}
+
+// KnockoutRound represents the knockout matches that belong to the same round (e.g. the semi-finals)
+type KnockoutRound struct {
+	Name    string // name of the round inferred from its match ids (e.g. "SF" for matches "SF1" and "SF2")
+	Matches MatchCollection
+}
+
+// KnockoutRounds returns the tournament's knockout matches grouped by round, or an empty slice if the tournament has no knockout matches
+//
+// Each round is inferred from its match ids (see inferRound). Rounds are ordered by the timestamp of their earliest match,
+// and matches within each round are ordered by timestamp - rounds or matches with an identical timestamp retain the order of the tournament's matches
+func (t *Tournament) KnockoutRounds() []KnockoutRound {
+	rounds := make([]KnockoutRound, 0)
+	if t == nil {
+		return rounds
+	}
+
+	idxByName := make(map[string]int)
+	for _, match := range t.Matches.FilterByStage(KnockoutStage) {
+		name := inferRound(match.ID)
+
+		idx, ok := idxByName[name]
+		if !ok {
+			idx = len(rounds)
+			idxByName[name] = idx
+			rounds = append(rounds, KnockoutRound{Name: name})
+		}
+
+		rounds[idx].Matches = append(rounds[idx].Matches, match)
+	}
+
+	for _, round := range rounds {
+		matches := round.Matches
+		sort.SliceStable(matches, func(i, j int) bool {
+			return matches[i].Timestamp.Before(matches[j].Timestamp)
+		})
+	}
+
+	sort.SliceStable(rounds, func(i, j int) bool {
+		return rounds[i].Matches[0].Timestamp.Before(rounds[j].Matches[0].Timestamp)
+	})
+
+	return rounds
+}
+
+// getTeamByID returns the tournament team with the provided id, regardless of case if the tournament has case-insensitive team ids
+func (t *Tournament) getTeamByID(id string) *Team {
+	if t.CaseInsensitiveTeamIDs {
+		return t.Teams.GetByIDFold(id)
+	}
+
+	return t.Teams.GetByID(id)
+}
+
+// Clock provides the current time, so that time-dependent behaviour can be reproduced
+type Clock interface {
+	Now() time.Time
+}
+
+// realClock provides the current system time
+type realClock struct{}
+
+func (realClock) Now() time.Time {
+	return time.Now()
+}
+
+// now returns the current time in the tournament's location, according to the tournament's clock (or the system time if the tournament has no clock)
+func (t *Tournament) now() time.Time {
+	var clock Clock = realClock{}
+	if t != nil && t.Clock != nil {
+		clock = t.Clock
+	}
+
+	return t.inLocation(clock.Now())
+}
+
+// MatchPlayed returns true if the provided match has kicked off according to the tournament's clock (see Match.Played)
+func (t *Tournament) MatchPlayed(m *Match) bool {
+	return m.Played(t.now())
+}
+
+// inLocation returns the provided timestamp in the tournament's location, or as-is if the tournament has no location
+func (t *Tournament) inLocation(ts time.Time) time.Time {
+	if t == nil || t.Location == nil {
+		return ts
+	}
+
+	return ts.In(t.Location)
 }
 
 type TeamsLoader interface {
@@ -38,8 +320,15 @@ type TournamentFSLoader struct {
 	fSys       fs.FS
 	configPath string
 	markupPath string
+	markupSrc  BytesFunc
 	tl         TeamsLoader
 	ml         MatchesLoader
+	clock      Clock
+
+	variantPaths          map[string]string
+	participantMarkupPath string
+	optionalMarkup        bool
+	validationOpts        ValidationOptions
 }
 
 func (t *TournamentFSLoader) WithFileSystem(fSys fs.FS) *TournamentFSLoader {
@@ -52,11 +341,49 @@ func (t *TournamentFSLoader) WithConfigPath(path string) *TournamentFSLoader {
 	return t
 }
 
+// WithMarkupPath reads the markup from the provided path within the loader's file system
+//
+// This is a convenience for WithMarkupSource, which takes precedence if both are provided
 func (t *TournamentFSLoader) WithMarkupPath(path string) *TournamentFSLoader {
 	t.markupPath = path
 	return t
 }
 
+// WithMarkupSource reads the markup from the provided source (e.g. BytesFromURL for a remote template store)
+func (t *TournamentFSLoader) WithMarkupSource(bytesFn BytesFunc) *TournamentFSLoader {
+	t.markupSrc = bytesFn
+	return t
+}
+
+// WithMarkupVariantPath reads an alternative markup with the provided name (e.g. "mobile") from the provided path within the loader's file system
+//
+// A variant is optional, so the tournament is loaded without it if the path does not exist (see Sweepstake.GenerateMarkupVariant)
+func (t *TournamentFSLoader) WithMarkupVariantPath(name, path string) *TournamentFSLoader {
+	if t.variantPaths == nil {
+		t.variantPaths = make(map[string]string)
+	}
+	t.variantPaths[name] = path
+	return t
+}
+
+// WithParticipantMarkupPath reads the markup of each participant's page from the provided path within the loader's file system
+//
+// The participant markup is optional, so the tournament is loaded without it if the path does not exist (see Sweepstake.GenerateParticipantMarkup)
+func (t *TournamentFSLoader) WithParticipantMarkupPath(path string) *TournamentFSLoader {
+	t.participantMarkupPath = path
+	return t
+}
+
+// WithOptionalMarkup determines whether the tournament can be loaded without markup
+//
+// If optional is false, a markup source must be provided and must exist (default).
+// Otherwise, a missing markup source or markup file is tolerated and the tournament is loaded without a template,
+// so that it can still be used to generate prize JSON and text
+func (t *TournamentFSLoader) WithOptionalMarkup(optional bool) *TournamentFSLoader {
+	t.optionalMarkup = optional
+	return t
+}
+
 func (t *TournamentFSLoader) WithTeamsLoader(tl TeamsLoader) *TournamentFSLoader {
 	t.tl = tl
 	return t
@@ -67,6 +394,18 @@ func (t *TournamentFSLoader) WithMatchesLoader(ml MatchesLoader) *TournamentFSLo
 	return t
 }
 
+// WithClock sets the clock that is used by the tournament to obtain the current time (defaults to the system time)
+func (t *TournamentFSLoader) WithClock(clock Clock) *TournamentFSLoader {
+	t.clock = clock
+	return t
+}
+
+// WithValidationOptions customises the messages of the errors that are returned when loading the tournament
+func (t *TournamentFSLoader) WithValidationOptions(opts ValidationOptions) *TournamentFSLoader {
+	t.validationOpts = opts
+	return t
+}
+
 func (t *TournamentFSLoader) init() error {
 	if t.fSys == nil {
 		t.fSys = defaultFileSystem
@@ -76,8 +415,8 @@ func (t *TournamentFSLoader) init() error {
 		return fmt.Errorf("config path: %w", ErrIsEmpty)
 	}
 
-	if t.markupPath == "" {
-		return fmt.Errorf("config path: %w", ErrIsEmpty)
+	if t.markupSrc == nil && t.markupPath == "" && !t.optionalMarkup {
+		return fmt.Errorf("markup source: %w", ErrIsEmpty)
 	}
 
 	if t.tl == nil {
@@ -92,6 +431,15 @@ func (t *TournamentFSLoader) init() error {
 }
 
 func (t *TournamentFSLoader) LoadTournament(ctx context.Context) (*Tournament, error) {
+	tournament, err := t.loadTournament(ctx)
+	if err != nil {
+		return nil, t.validationOpts.apply(err)
+	}
+
+	return tournament, nil
+}
+
+func (t *TournamentFSLoader) loadTournament(ctx context.Context) (*Tournament, error) {
 	if err := t.init(); err != nil {
 		return nil, err
 	}
@@ -120,13 +468,260 @@ func (t *TournamentFSLoader) LoadTournament(ctx context.Context) (*Tournament, e
 
 	tournament.Teams = teams
 	tournament.Matches = matches
+	tournament.Clock = t.clock
 
 	// parse markup as template
-	rawMarkup, err := readFile(t.fSys, t.markupPath)
+	rawMarkup, err := t.readMarkup()
+	if err != nil {
+		return nil, err
+	}
+
+	if rawMarkup != nil {
+		tpl, err := parseMarkup(tournament, rawMarkup)
+		if err != nil {
+			return nil, err
+		}
+
+		tournament.Template = tpl
+	}
+
+	variants, err := t.loadVariants(tournament)
+	if err != nil {
+		return nil, err
+	}
+	tournament.Variants = variants
+
+	participantTpl, err := t.loadParticipantMarkup(tournament)
 	if err != nil {
 		return nil, err
 	}
+	tournament.ParticipantTemplate = participantTpl
+
+	mErr := NewMultiError()
+	validateTournament(tournament, mErr)
+
+	if !mErr.IsEmpty() {
+		return nil, mErr
+	}
+
+	if tournament.ValidateMarkup && tournament.Template != nil {
+		if err := validateMarkup(tournament); err != nil {
+			return nil, fmt.Errorf("markup: %w", err)
+		}
+	}
+
+	return tournament, nil
+}
 
+// readMarkup returns the tournament's raw markup, or nil if the markup is optional and has not been provided
+func (t *TournamentFSLoader) readMarkup() ([]byte, error) {
+	markupSrc := t.markupSrc
+	if markupSrc == nil {
+		if t.markupPath == "" {
+			return nil, nil
+		}
+		markupSrc = BytesFromFileSystem(t.fSys, t.markupPath)
+	}
+
+	rawMarkup, err := markupSrc()
+	if err != nil {
+		if t.optionalMarkup && errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return rawMarkup, nil
+}
+
+// loadVariants returns each of the tournament's markup variants that exists, parsed as a template and keyed by name, or nil if there are none
+func (t *TournamentFSLoader) loadVariants(tournament *Tournament) (map[string]*template.Template, error) {
+	names := make([]string, 0, len(t.variantPaths))
+	for name := range t.variantPaths {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var variants map[string]*template.Template
+
+	for _, name := range names {
+		rawMarkup, err := readFile(t.fSys, t.variantPaths[name])
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("markup variant '%s': %w", name, err)
+		}
+
+		tpl, err := parseMarkup(tournament, rawMarkup)
+		if err != nil {
+			return nil, fmt.Errorf("markup variant '%s': %w", name, err)
+		}
+
+		if variants == nil {
+			variants = make(map[string]*template.Template)
+		}
+		variants[name] = tpl
+	}
+
+	return variants, nil
+}
+
+// loadParticipantMarkup returns the tournament's participant markup parsed as a template, or nil if it has not been provided or does not exist
+func (t *TournamentFSLoader) loadParticipantMarkup(tournament *Tournament) (*template.Template, error) {
+	if t.participantMarkupPath == "" {
+		return nil, nil
+	}
+
+	rawMarkup, err := readFile(t.fSys, t.participantMarkupPath)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("participant markup: %w", err)
+	}
+
+	tpl, err := parseMarkup(tournament, rawMarkup)
+	if err != nil {
+		return nil, fmt.Errorf("participant markup: %w", err)
+	}
+
+	return tpl, nil
+}
+
+// names of the files that a tournament bundle contains by convention (see TournamentBundleLoader)
+const (
+	bundleConfigFile            = "tournament.json"
+	bundleTeamsFile             = "teams.json"
+	bundleMatchesFile           = "matches.csv"
+	bundleMatchesUpdatesFile    = "matches_updates.csv"
+	bundleMarkupFile            = "markup.gohtml"
+	bundleParticipantMarkupFile = "markup_participant.gohtml"
+	bundleVariantMarkupPattern  = "markup_*.gohtml"
+)
+
+// requiredBundleFiles defines the files that a tournament bundle must contain
+var requiredBundleFiles = []string{bundleConfigFile, bundleTeamsFile, bundleMatchesFile, bundleMarkupFile}
+
+// TournamentBundleLoader loads a tournament from a "bundle" directory, which contains each of the tournament's files by convention:
+//
+//   - tournament.json, teams.json, matches.csv and markup.gohtml are required
+//   - matches_updates.csv is applied to the matches, if it exists (see MatchesCSVLoader.WithUpdatesPath)
+//   - markup_participant.gohtml is the markup of each participant's page, if it exists (see TournamentFSLoader.WithParticipantMarkupPath)
+//   - any other markup_{name}.gohtml is the markup variant with that name (e.g. markup_mobile.gohtml is the "mobile" variant)
+//
+// This wraps the teams, matches and tournament loaders, so that the tournament is loaded and validated exactly as it would be by each of them
+type TournamentBundleLoader struct {
+	fSys     fs.FS
+	path     string
+	warnings MultiError
+	clock    Clock
+}
+
+func (t *TournamentBundleLoader) WithFileSystem(fSys fs.FS) *TournamentBundleLoader {
+	t.fSys = fSys
+	return t
+}
+
+// WithPath sets the path of the bundle directory within the loader's file system
+func (t *TournamentBundleLoader) WithPath(path string) *TournamentBundleLoader {
+	t.path = path
+	return t
+}
+
+// WithMissingImageWarnings records a team with an empty image url as a warning within the provided collector, rather than failing validation
+//
+// If warnings is empty (nil), a team with an empty image url fails validation (default)
+func (t *TournamentBundleLoader) WithMissingImageWarnings(warnings MultiError) *TournamentBundleLoader {
+	t.warnings = warnings
+	return t
+}
+
+// WithClock sets the clock that is used by the tournament to obtain the current time (defaults to the system time)
+func (t *TournamentBundleLoader) WithClock(clock Clock) *TournamentBundleLoader {
+	t.clock = clock
+	return t
+}
+
+func (t *TournamentBundleLoader) init() error {
+	if t.fSys == nil {
+		t.fSys = defaultFileSystem
+	}
+
+	if t.path == "" {
+		return fmt.Errorf("path: %w", ErrIsEmpty)
+	}
+
+	return nil
+}
+
+func (t *TournamentBundleLoader) LoadTournament(ctx context.Context) (*Tournament, error) {
+	if err := t.init(); err != nil {
+		return nil, err
+	}
+
+	// ensure that each required file exists, so that every missing file is reported rather than the first to be read
+	mErr := NewMultiError()
+	for _, name := range requiredBundleFiles {
+		_, err := fs.Stat(t.fSys, path.Join(t.path, name))
+		switch {
+		case errors.Is(err, fs.ErrNotExist):
+			mErr.Add(fmt.Errorf("bundle '%s': file '%s': %w", t.path, name, ErrNotFound))
+		case err != nil:
+			return nil, fmt.Errorf("bundle '%s': %w", t.path, err)
+		}
+	}
+
+	if !mErr.IsEmpty() {
+		return nil, mErr
+	}
+
+	teamsLoader := (&TeamsJSONLoader{}).
+		WithFileSystem(t.fSys).
+		WithPath(path.Join(t.path, bundleTeamsFile))
+
+	if t.warnings != nil {
+		teamsLoader.WithMissingImageWarnings(t.warnings)
+	}
+
+	matchesLoader := (&MatchesCSVLoader{}).
+		WithFileSystem(t.fSys).
+		WithPath(path.Join(t.path, bundleMatchesFile))
+
+	updatesPath := path.Join(t.path, bundleMatchesUpdatesFile)
+	if _, err := fs.Stat(t.fSys, updatesPath); err == nil {
+		matchesLoader.WithUpdatesPath(updatesPath)
+	}
+
+	loader := (&TournamentFSLoader{}).
+		WithFileSystem(t.fSys).
+		WithTeamsLoader(teamsLoader).
+		WithMatchesLoader(matchesLoader).
+		WithConfigPath(path.Join(t.path, bundleConfigFile)).
+		WithMarkupPath(path.Join(t.path, bundleMarkupFile)).
+		WithParticipantMarkupPath(path.Join(t.path, bundleParticipantMarkupFile)).
+		WithClock(t.clock)
+
+	// discover markup variants
+	variantPaths, err := fs.Glob(t.fSys, path.Join(t.path, bundleVariantMarkupPattern))
+	if err != nil {
+		return nil, fmt.Errorf("bundle '%s': %w", t.path, err)
+	}
+
+	for _, variantPath := range variantPaths {
+		name := path.Base(variantPath)
+		if name == bundleParticipantMarkupFile {
+			continue
+		}
+
+		loader.WithMarkupVariantPath(strings.TrimSuffix(strings.TrimPrefix(name, "markup_"), ".gohtml"), variantPath)
+	}
+
+	return loader.LoadTournament(ctx)
+}
+
+// parseMarkup parses the provided markup as a template for the provided tournament
+func parseMarkup(tournament *Tournament, rawMarkup []byte) (*template.Template, error) {
 	tpl, err := template.
 		New("tpl").
 		Funcs(map[string]any{
@@ -162,18 +757,44 @@ func (t *TournamentFSLoader) LoadTournament(ctx context.Context) (*Tournament, e
 
 				return filtered
 			},
+			"paginate_matches": func(collection MatchCollection) []MatchPage {
+				return collection.Paginate(tournament.MatchesPerPage)
+			},
 			"strip_text": func(input string) string {
 				replaced := rx.ReplaceAll([]byte(input), []byte(""))
 				return strings.Trim(string(replaced), " ")
 			},
 			"get_summary": func(t *Team, p *Participant) string {
-				return getSummaryFromTeamAndParticipant(t, p)
+				return getSummaryFromTeamAndParticipant(tournament, t, p)
 			},
 			"get_participant_by_id": func(collection ParticipantCollection, id string) *Participant {
 				return collection.GetByTeamID(id)
 			},
+			"entrants": func(s *Sweepstake) []string {
+				return s.ParticipantNames()
+			},
+			"gravatar": func(p *Participant) string {
+				return p.GravatarURL()
+			},
 			"short_date": func(t time.Time) string {
-				return t.Format("02/01")
+				return tournament.inLocation(t).Format("02/01")
+			},
+			"humanize_int": humanizeInt,
+			"head_to_head": func(a, b *Team) headToHeadRecord {
+				winsA, winsB, draws := tournament.Matches.HeadToHead(a, b)
+				return headToHeadRecord{WinsA: winsA, WinsB: winsB, Draws: draws}
+			},
+			"form": func(team *Team, n int) string {
+				return tournament.Matches.FormFor(team, n)
+			},
+			"top_scoring_team": func() *Team {
+				return tournament.TopScoringTeam()
+			},
+			"played": func(m *Match) bool {
+				return tournament.MatchPlayed(m)
+			},
+			"t": func(label string) string {
+				return label // translated within the language of the sweepstake that is rendered (see Sweepstake.localise)
 			},
 			"sort_teams": func(collection TeamCollection) TeamCollection {
 				var sorted TeamCollection
@@ -195,22 +816,54 @@ func (t *TournamentFSLoader) LoadTournament(ctx context.Context) (*Tournament, e
 		return nil, fmt.Errorf("cannot parse template: %w", err)
 	}
 
-	tournament.Template = tpl
+	return tpl, nil
+}
 
-	mErr := NewMultiError()
-	validateTournament(tournament, mErr)
+// validateMarkup ensures that the tournament's template can be executed for a representative sweepstake and renders some content
+//
+// The representative sweepstake has an unnamed participant for each team, and no prizes
+func validateMarkup(tournament *Tournament) error {
+	participants := make(ParticipantCollection, 0)
+	for _, team := range tournament.Teams {
+		participants = append(participants, &Participant{TeamID: team.ID})
+	}
 
-	if !mErr.IsEmpty() {
-		return nil, mErr
+	markup, err := (&Sweepstake{
+		Tournament:   tournament,
+		Participants: participants,
+	}).GenerateMarkup()
+	if err != nil {
+		return err
 	}
 
-	return tournament, nil
+	if len(bytes.TrimSpace(markup)) == 0 {
+		return fmt.Errorf("output: %w", ErrIsEmpty)
+	}
+
+	return nil
+}
+
+// humanizeInt returns the provided integer with its thousands separated by commas (e.g. 1234567 becomes "1,234,567")
+func humanizeInt(n int) string {
+	digits := strconv.Itoa(n)
+
+	sign := ""
+	if n < 0 {
+		sign, digits = "-", digits[1:]
+	}
+
+	for idx := len(digits) - 3; idx > 0; idx -= 3 {
+		digits = digits[:idx] + "," + digits[idx:]
+	}
+
+	return sign + digits
 }
 
 func validateTournament(tournament *Tournament, mErr MultiError) {
 	tournament.ID = strings.Trim(tournament.ID, " ")
 	tournament.Name = strings.Trim(tournament.Name, " ")
 	tournament.ImageURL = strings.Trim(tournament.ImageURL, " ")
+	tournament.FinalMatchID = strings.Trim(tournament.FinalMatchID, " ")
 
 	if tournament.ID == "" {
 		mErr.Add(fmt.Errorf("id: %w", ErrIsEmpty))
@@ -224,6 +877,32 @@ func validateTournament(tournament *Tournament, mErr MultiError) {
 		mErr.Add(fmt.Errorf("image url: %w", ErrIsEmpty))
 	}
 
+	if err := validateSummaryFormat(tournament.SummaryFormat); err != nil {
+		mErr.Add(fmt.Errorf("summary format '%s': %w", tournament.SummaryFormat, err))
+	}
+
+	validateValueTemplates(tournament, mErr)
+
+	if tournament.MatchesPerPage < 0 {
+		mErr.Add(fmt.Errorf("matches per page %d must not be negative", tournament.MatchesPerPage))
+	}
+
+	if tournament.HighScoringGoals < 0 {
+		mErr.Add(fmt.Errorf("high scoring goals %d must not be negative", tournament.HighScoringGoals))
+	}
+
+	if tournament.StaleMatchHours < 0 {
+		mErr.Add(fmt.Errorf("stale match hours %d must not be negative", tournament.StaleMatchHours))
+	}
+
+	if tournament.Timezone != "" {
+		loc, err := time.LoadLocation(tournament.Timezone)
+		if err != nil {
+			mErr.Add(fmt.Errorf("timezone '%s': %w", tournament.Timezone, err))
+		}
+		tournament.Location = loc
+	}
+
 	audit := &teamsAudit{teams: tournament.Teams}
 
 	for idx, match := range tournament.Matches {
@@ -231,13 +910,13 @@ func validateTournament(tournament *Tournament, mErr MultiError) {
 		mErrMatch := mErr.WithPrefix(fmt.Sprintf("match %d", matchNum))
 
 		// enrich team entities based on existing ids
-		if err := populateTeamByID(match.Home.Team, tournament.Teams); err != nil {
+		if err := populateTeamByID(match.Home.Team, tournament); err != nil {
 			mErrMatch.Add(fmt.Errorf("home: %w", err))
 		}
-		if err := populateTeamByID(match.Away.Team, tournament.Teams); err != nil {
+		if err := populateTeamByID(match.Away.Team, tournament); err != nil {
 			mErrMatch.Add(fmt.Errorf("away: %w", err))
 		}
-		if err := populateTeamByID(match.Winner, tournament.Teams); err != nil {
+		if err := populateTeamByID(match.Winner, tournament); err != nil {
 			mErrMatch.Add(fmt.Errorf("winner: %w", err))
 		}
 
@@ -247,9 +926,85 @@ func validateTournament(tournament *Tournament, mErr MultiError) {
 	}
 
 	audit.validate(mErr, false)
+
+	// a configured final that does not exist would otherwise leave the outright prizes unresolved indefinitely
+	if tournament.FinalMatchID != "" && tournament.Matches.GetByID(tournament.FinalMatchID) == nil {
+		mErr.Add(fmt.Errorf("final match id '%s': %w", tournament.FinalMatchID, ErrNotFound))
+	}
+
+	if tournament.ValidateBracket {
+		validateBracket(tournament.Matches, mErr)
+	}
 }
 
-func populateTeamByID(team *Team, collection TeamCollection) error {
+// validateBracket ensures that no team wins more than one knockout match within the same round
+func validateBracket(matches MatchCollection, mErr MultiError) {
+	var rounds []string                                 // preserves order of first appearance
+	winsByRound := make(map[string]map[string][]string) // round => team id => match ids
+	teamIDsByRound := make(map[string][]string)         // preserves order of first appearance
+
+	for _, match := range matches {
+		if match.Stage != KnockoutStage || match.Winner == nil {
+			continue // teams can legitimately win multiple group stage matches
+		}
+
+		round := inferRound(match.ID)
+		if _, ok := winsByRound[round]; !ok {
+			rounds = append(rounds, round)
+			winsByRound[round] = make(map[string][]string)
+		}
+
+		teamID := match.Winner.ID
+		if _, ok := winsByRound[round][teamID]; !ok {
+			teamIDsByRound[round] = append(teamIDsByRound[round], teamID)
+		}
+		winsByRound[round][teamID] = append(winsByRound[round][teamID], match.ID)
+	}
+
+	for _, round := range rounds {
+		for _, teamID := range teamIDsByRound[round] {
+			if matchIDs := winsByRound[round][teamID]; len(matchIDs) > 1 {
+				mErr.Add(fmt.Errorf("team id '%s': won multiple matches in round '%s': %s", teamID, round, strings.Join(matchIDs, ", ")))
+			}
+		}
+	}
+}
+
+func validateSummaryFormat(format string) error {
+	if format == "" {
+		return nil // default format applies
+	}
+
+	var count int
+	for _, verb := range verbRx.FindAllString(format, -1) {
+		switch verb {
+		case "%%":
+			continue // escaped percent sign
+		case "%s", "%[1]s", "%[2]s":
+			count++
+		default:
+			return fmt.Errorf("unsupported verb: %s", verb)
+		}
+	}
+
+	if count != 2 {
+		return fmt.Errorf("must contain exactly 2 string verbs, found %d", count)
+	}
+
+	// render the format with sentinel values, to detect verbs that read a missing argument or repeat one argument instead of the other
+	const participantSentinel, teamSentinel = "\x00participant\x00", "\x00team\x00"
+	rendered := fmt.Sprintf(format, participantSentinel, teamSentinel)
+	switch {
+	case strings.Contains(rendered, "%!"):
+		return errors.New("must only reference arguments 1 and 2")
+	case strings.Count(rendered, participantSentinel) != 1 || strings.Count(rendered, teamSentinel) != 1:
+		return errors.New("must reference each of arguments 1 and 2 exactly once")
+	}
+
+	return nil
+}
+
+func populateTeamByID(team *Team, tournament *Tournament) error {
 	if team == nil {
 		return nil
 	}
@@ -258,7 +1013,7 @@ func populateTeamByID(team *Team, collection TeamCollection) error {
 		return nil
 	}
 
-	t := collection.GetByID(team.ID)
+	t := tournament.getTeamByID(team.ID)
 	if t == nil {
 		return fmt.Errorf("team id '%s': %w", team.ID, ErrNotFound)
 	}
@@ -280,6 +1035,17 @@ func (tc TournamentCollection) GetByID(id string) *Tournament {
 	return nil
 }
 
+// Merge returns a new collection comprising the tournaments of the collection followed by those of the provided collection
+//
+// Neither collection is modified, and a tournament id that appears in both collections produces an error
+func (tc TournamentCollection) Merge(other TournamentCollection) (TournamentCollection, error) {
+	merged := make(TournamentCollection, 0, len(tc)+len(other))
+	merged = append(merged, tc...)
+	merged = append(merged, other...)
+
+	return validateTournaments(merged)
+}
+
 type TournamentLoader interface {
 	LoadTournament(ctx context.Context) (*Tournament, error)
 }
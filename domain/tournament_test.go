@@ -5,10 +5,12 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"html/template"
 	"io/fs"
 	"path/filepath"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/sweepstake-markup-generator/domain"
 )
@@ -141,6 +143,295 @@ func TestTournamentFSLoader_LoadTournament(t *testing.T) {
 				"image url: is empty",
 			}),
 		},
+		{
+			name:           "custom summary format must be loaded successfully",
+			configFilename: "tournament_config_summary_format.json",
+			markupFilename: tournamentMarkupOkFilename,
+			teamsLoader:    defaultMockTeamsLoader,
+			matchesLoader:  defaultMockMatchesLoader,
+			wantTournament: &domain.Tournament{
+				ID:            "TestTourney1",
+				Name:          "Test Tournament 1",
+				ImageURL:      "http://tourney.jpg",
+				Teams:         defaultTeamCollection,
+				Matches:       defaultMatchCollection,
+				Template:      parseTemplate(t, "<h1>Hello World</h1>"),
+				SummaryFormat: "%[2]s — %[1]s",
+			},
+		},
+		{
+			name:           "summary format with wrong number of verbs must produce the expected error",
+			configFilename: "tournament_config_invalid_summary_format.json",
+			markupFilename: tournamentMarkupOkFilename,
+			teamsLoader:    defaultMockTeamsLoader,
+			matchesLoader:  defaultMockMatchesLoader,
+			wantErr: newMultiError([]string{
+				"summary format '%s (%s) %s': must contain exactly 2 string verbs, found 3",
+			}),
+		},
+		{
+			name:           "summary format that references a missing argument must produce the expected error",
+			configFilename: "tournament_config_summary_format_missing_argument.json",
+			markupFilename: tournamentMarkupOkFilename,
+			teamsLoader:    defaultMockTeamsLoader,
+			matchesLoader:  defaultMockMatchesLoader,
+			wantErr: newMultiError([]string{
+				"summary format '%[2]s — %s': must only reference arguments 1 and 2",
+			}),
+		},
+		{
+			name:           "summary format that repeats an argument must produce the expected error",
+			configFilename: "tournament_config_summary_format_repeated_argument.json",
+			markupFilename: tournamentMarkupOkFilename,
+			teamsLoader:    defaultMockTeamsLoader,
+			matchesLoader:  defaultMockMatchesLoader,
+			wantErr: newMultiError([]string{
+				"summary format '%[2]s — %[2]s': must reference each of arguments 1 and 2 exactly once",
+			}),
+		},
+		{
+			name:           "bracket validation must permit a team winning multiple group matches and one knockout match per round",
+			configFilename: "tournament_config_validate_bracket.json",
+			markupFilename: tournamentMarkupOkFilename,
+			teamsLoader:    newMockTeamsLoader(bracketTeamCollection, nil),
+			matchesLoader: newMockMatchesLoader(domain.MatchCollection{
+				newBracketMatch("A1", domain.GroupStage, "123", "456", "123"),
+				newBracketMatch("A2", domain.GroupStage, "123", "789", "123"),
+				newBracketMatch("SF1", domain.KnockoutStage, "123", "456", "123"),
+				newBracketMatch("SF2", domain.KnockoutStage, "789", "000", "789"),
+				newBracketMatch("F", domain.KnockoutStage, "123", "789", "123"),
+			}, nil),
+			wantTournament: &domain.Tournament{
+				ID:       "TestTourney1",
+				Name:     "Test Tournament 1",
+				ImageURL: "http://tourney.jpg",
+				Teams:    bracketTeamCollection,
+				Matches: domain.MatchCollection{
+					newBracketMatch("A1", domain.GroupStage, "123", "456", "123"),
+					newBracketMatch("A2", domain.GroupStage, "123", "789", "123"),
+					newBracketMatch("SF1", domain.KnockoutStage, "123", "456", "123"),
+					newBracketMatch("SF2", domain.KnockoutStage, "789", "000", "789"),
+					newBracketMatch("F", domain.KnockoutStage, "123", "789", "123"),
+				},
+				Template:        parseTemplate(t, "<h1>Hello World</h1>"),
+				ValidateBracket: true,
+			},
+		},
+		{
+			name:           "bracket validation must produce the expected error for a team winning multiple knockout matches in the same round",
+			configFilename: "tournament_config_validate_bracket.json",
+			markupFilename: tournamentMarkupOkFilename,
+			teamsLoader:    newMockTeamsLoader(bracketTeamCollection, nil),
+			matchesLoader: newMockMatchesLoader(domain.MatchCollection{
+				newBracketMatch("SF1 [61]", domain.KnockoutStage, "123", "456", "123"),
+				newBracketMatch("SF2 [62]", domain.KnockoutStage, "789", "123", "123"),
+				newBracketMatch("F", domain.KnockoutStage, "123", "000", "123"),
+			}, nil),
+			wantErr: newMultiError([]string{
+				"team id '123': won multiple matches in round 'SF': SF1 [61], SF2 [62]",
+			}),
+		},
+		{
+			name:           "markup validation must permit markup that renders content",
+			configFilename: "tournament_config_validate_markup.json",
+			markupFilename: tournamentMarkupOkFilename,
+			teamsLoader:    defaultMockTeamsLoader,
+			matchesLoader:  defaultMockMatchesLoader,
+			wantTournament: &domain.Tournament{
+				ID:             "TestTourney1",
+				Name:           "Test Tournament 1",
+				ImageURL:       "http://tourney.jpg",
+				Teams:          defaultTeamCollection,
+				Matches:        defaultMatchCollection,
+				Template:       parseTemplate(t, "<h1>Hello World</h1>"),
+				ValidateMarkup: true,
+			},
+		},
+		{
+			name:           "markup validation must produce the expected error for markup that renders nothing",
+			configFilename: "tournament_config_validate_markup.json",
+			markupFilename: "tournament_markup_empty_output.gohtml",
+			teamsLoader:    defaultMockTeamsLoader,
+			matchesLoader:  defaultMockMatchesLoader,
+			wantErr:        errors.New("markup: output: is empty"),
+		},
+		{
+			name:           "markup validation must produce the expected error for markup that cannot be executed",
+			configFilename: "tournament_config_validate_markup.json",
+			markupFilename: "tournament_markup_invalid_field.gohtml",
+			teamsLoader:    defaultMockTeamsLoader,
+			matchesLoader:  defaultMockMatchesLoader,
+			wantErr: errors.New(`markup: cannot execute template: template: tpl:1:18: executing "tpl" at <.Sweepstake.NotAField>: ` +
+				`can't evaluate field NotAField in type *domain.Sweepstake`),
+		},
+		{
+			name:           "markup that renders nothing must not produce an error without markup validation",
+			configFilename: tournamentConfigOkFilename,
+			markupFilename: "tournament_markup_empty_output.gohtml",
+			teamsLoader:    defaultMockTeamsLoader,
+			matchesLoader:  defaultMockMatchesLoader,
+			wantTournament: &domain.Tournament{
+				ID:              "TestTourney1",
+				Name:            "Test Tournament 1",
+				ImageURL:        "http://tourney.jpg",
+				Teams:           defaultTeamCollection,
+				Matches:         defaultMatchCollection,
+				Template:        parseTemplate(t, "\n"),
+				WithLastUpdated: true,
+			},
+		},
+		{
+			name:           "team winning multiple knockout matches in the same round must not produce an error without bracket validation",
+			configFilename: tournamentConfigOkFilename,
+			markupFilename: tournamentMarkupOkFilename,
+			teamsLoader:    newMockTeamsLoader(bracketTeamCollection, nil),
+			matchesLoader: newMockMatchesLoader(domain.MatchCollection{
+				newBracketMatch("SF1", domain.KnockoutStage, "123", "456", "123"),
+				newBracketMatch("SF2", domain.KnockoutStage, "789", "123", "123"),
+				newBracketMatch("F", domain.KnockoutStage, "123", "000", "123"),
+			}, nil),
+			wantTournament: &domain.Tournament{
+				ID:       "TestTourney1",
+				Name:     "Test Tournament 1",
+				ImageURL: "http://tourney.jpg",
+				Teams:    bracketTeamCollection,
+				Matches: domain.MatchCollection{
+					newBracketMatch("SF1", domain.KnockoutStage, "123", "456", "123"),
+					newBracketMatch("SF2", domain.KnockoutStage, "789", "123", "123"),
+					newBracketMatch("F", domain.KnockoutStage, "123", "000", "123"),
+				},
+				Template:        parseTemplate(t, "<h1>Hello World</h1>"),
+				WithLastUpdated: true,
+			},
+		},
+		{
+			name:           "humanize_int template func must separate thousands with commas",
+			configFilename: tournamentConfigOkFilename,
+			markupFilename: "tournament_markup_humanize_int.gohtml",
+			teamsLoader:    defaultMockTeamsLoader,
+			matchesLoader:  defaultMockMatchesLoader,
+			wantTournament: &domain.Tournament{
+				ID:              "TestTourney1",
+				Name:            "Test Tournament 1",
+				ImageURL:        "http://tourney.jpg",
+				Teams:           defaultTeamCollection,
+				Matches:         defaultMatchCollection,
+				Template:        parseTemplate(t, "0|999|1,000|1,234,567|-1,234\n"),
+				WithLastUpdated: true,
+			},
+		},
+		{
+			name:           "timezone must be loaded as tournament location",
+			configFilename: "tournament_config_timezone.json",
+			markupFilename: tournamentMarkupOkFilename,
+			teamsLoader:    defaultMockTeamsLoader,
+			matchesLoader:  defaultMockMatchesLoader,
+			wantTournament: &domain.Tournament{
+				ID:       "TestTourney1",
+				Name:     "Test Tournament 1",
+				ImageURL: "http://tourney.jpg",
+				Teams:    defaultTeamCollection,
+				Matches:  defaultMatchCollection,
+				Template: parseTemplate(t, "<h1>Hello World</h1>"),
+				Timezone: "Asia/Tokyo",
+				Location: mustLoadLocation(t, "Asia/Tokyo"),
+			},
+		},
+		{
+			name:           "value templates must be loaded",
+			configFilename: "tournament_config_value_templates.json",
+			markupFilename: tournamentMarkupOkFilename,
+			teamsLoader:    defaultMockTeamsLoader,
+			matchesLoader:  defaultMockMatchesLoader,
+			wantTournament: &domain.Tournament{
+				ID:       "TestTourney1",
+				Name:     "Test Tournament 1",
+				ImageURL: "http://tourney.jpg",
+				Teams:    defaultTeamCollection,
+				Matches:  defaultMatchCollection,
+				Template: parseTemplate(t, "<h1>Hello World</h1>"),
+				ValueTemplates: map[string]string{
+					"most_goals_conceded": "{{ .Value }} conceded by {{ .Team.Name }}",
+				},
+			},
+		},
+		{
+			name:           "invalid value templates must produce the expected error",
+			configFilename: "tournament_config_invalid_value_templates.json",
+			markupFilename: tournamentMarkupOkFilename,
+			teamsLoader:    defaultMockTeamsLoader,
+			matchesLoader:  defaultMockMatchesLoader,
+			wantErr: newMultiError([]string{
+				"value template 'most_goals_conceded': cannot parse: template: most_goals_conceded:1: unclosed action",
+				"value template key 'most_own_goals': not found",
+			}),
+		},
+		{
+			name:           "unknown timezone must produce the expected error",
+			configFilename: "tournament_config_invalid_timezone.json",
+			markupFilename: tournamentMarkupOkFilename,
+			teamsLoader:    defaultMockTeamsLoader,
+			matchesLoader:  defaultMockMatchesLoader,
+			wantErr: newMultiError([]string{
+				"timezone 'Mars/Olympus_Mons': unknown time zone Mars/Olympus_Mons",
+			}),
+		},
+		{
+			name:           "final match id that exists must be loaded successfully",
+			configFilename: "tournament_config_final_match_id.json",
+			markupFilename: tournamentMarkupOkFilename,
+			teamsLoader:    defaultMockTeamsLoader,
+			matchesLoader:  defaultMockMatchesLoader,
+			wantTournament: &domain.Tournament{
+				ID:           "TestTourney1",
+				Name:         "Test Tournament 1",
+				ImageURL:     "http://tourney.jpg",
+				Teams:        defaultTeamCollection,
+				Matches:      defaultMatchCollection,
+				Template:     parseTemplate(t, "<h1>Hello World</h1>"),
+				FinalMatchID: "321",
+			},
+		},
+		{
+			name:           "final match id that does not exist must produce the expected error",
+			configFilename: "tournament_config_invalid_final_match_id.json",
+			markupFilename: tournamentMarkupOkFilename,
+			teamsLoader:    defaultMockTeamsLoader,
+			matchesLoader:  defaultMockMatchesLoader,
+			wantErr: newMultiError([]string{
+				"final match id 'GF': not found",
+			}),
+		},
+		{
+			name:           "negative matches per page must produce the expected error",
+			configFilename: "tournament_config_invalid_matches_per_page.json",
+			markupFilename: tournamentMarkupOkFilename,
+			teamsLoader:    defaultMockTeamsLoader,
+			matchesLoader:  defaultMockMatchesLoader,
+			wantErr: newMultiError([]string{
+				"matches per page -5 must not be negative",
+			}),
+		},
+		{
+			name:           "negative high scoring goals must produce the expected error",
+			configFilename: "tournament_config_invalid_high_scoring_goals.json",
+			markupFilename: tournamentMarkupOkFilename,
+			teamsLoader:    defaultMockTeamsLoader,
+			matchesLoader:  defaultMockMatchesLoader,
+			wantErr: newMultiError([]string{
+				"high scoring goals -1 must not be negative",
+			}),
+		},
+		{
+			name:           "negative stale match hours must produce the expected error",
+			configFilename: "tournament_config_invalid_stale_match_hours.json",
+			markupFilename: tournamentMarkupOkFilename,
+			teamsLoader:    defaultMockTeamsLoader,
+			matchesLoader:  defaultMockMatchesLoader,
+			wantErr: newMultiError([]string{
+				"stale match hours -1 must not be negative",
+			}),
+		},
 		{
 			name:           "teams that exist by id must be enriched successfully",
 			configFilename: tournamentConfigOkFilename,
@@ -155,84 +446,1059 @@ func TestTournamentFSLoader_LoadTournament(t *testing.T) {
 					Away:   domain.MatchCompetitor{Team: &domain.Team{ID: "456"}},
 					Winner: &domain.Team{ID: "123"},
 				},
-			}, nil),
-			wantTournament: &domain.Tournament{
-				ID:       "TestTourney1",
-				Name:     "Test Tournament 1",
-				ImageURL: "http://tourney.jpg",
-				Teams: domain.TeamCollection{
-					{ID: "123", Name: "Team123", ImageURL: "http://team123.jpg"},
-					{ID: "456", Name: "Team456", ImageURL: "http://team456.jpg"},
+			}, nil),
+			wantTournament: &domain.Tournament{
+				ID:       "TestTourney1",
+				Name:     "Test Tournament 1",
+				ImageURL: "http://tourney.jpg",
+				Teams: domain.TeamCollection{
+					{ID: "123", Name: "Team123", ImageURL: "http://team123.jpg"},
+					{ID: "456", Name: "Team456", ImageURL: "http://team456.jpg"},
+				},
+				Matches: domain.MatchCollection{
+					{
+						Home:   domain.MatchCompetitor{Team: &domain.Team{ID: "123", Name: "Team123", ImageURL: "http://team123.jpg"}}, // fully-enriched team
+						Away:   domain.MatchCompetitor{Team: &domain.Team{ID: "456", Name: "Team456", ImageURL: "http://team456.jpg"}}, // fully-enriched team
+						Winner: &domain.Team{ID: "123", Name: "Team123", ImageURL: "http://team123.jpg"},                               // fully-enriched team
+					},
+				},
+				Template:        parseTemplate(t, "<h1>Hello World</h1>"),
+				WithLastUpdated: true,
+			},
+		},
+		{
+			name:           "teams that do not exist by id must produce the expected error",
+			configFilename: tournamentConfigOkFilename,
+			markupFilename: tournamentMarkupOkFilename,
+			teamsLoader:    defaultMockTeamsLoader,
+			matchesLoader: newMockMatchesLoader(domain.MatchCollection{
+				{
+					Home: domain.MatchCompetitor{Team: &domain.Team{ID: "123"}}, // included in default team collection
+					Away: domain.MatchCompetitor{Team: &domain.Team{ID: "456"}}, // included in default team collection
+				},
+				{
+					Home:   domain.MatchCompetitor{Team: &domain.Team{ID: "AAA"}},
+					Away:   domain.MatchCompetitor{Team: &domain.Team{ID: "BBB"}},
+					Winner: &domain.Team{ID: "CCC"},
+				},
+			}, nil),
+			wantErr: newMultiError([]string{
+				"match 2: home: team id 'AAA': not found",
+				"match 2: away: team id 'BBB': not found",
+				"match 2: winner: team id 'CCC': not found",
+			}),
+		},
+		{
+			name:           "teams that exist by id with mismatched case must produce the expected error by default",
+			configFilename: tournamentConfigOkFilename,
+			markupFilename: tournamentMarkupOkFilename,
+			teamsLoader:    newMockTeamsLoader(caseSensitiveTeamCollection, nil),
+			matchesLoader: newMockMatchesLoader(domain.MatchCollection{
+				{
+					Home:   domain.MatchCompetitor{Team: &domain.Team{ID: "ptfc"}},
+					Away:   domain.MatchCompetitor{Team: &domain.Team{ID: "Sjrfc"}},
+					Winner: &domain.Team{ID: "ptfc"},
+				},
+			}, nil),
+			wantErr: newMultiError([]string{
+				"match 1: home: team id 'ptfc': not found",
+				"match 1: away: team id 'Sjrfc': not found",
+				"match 1: winner: team id 'ptfc': not found",
+				"team id 'PTFC': count 0",
+				"team id 'SJRFC': count 0",
+			}),
+		},
+		{
+			name:           "teams that exist by id with matching case must be enriched successfully with case-insensitive team ids",
+			configFilename: "tournament_config_case_insensitive_team_ids.json",
+			markupFilename: tournamentMarkupOkFilename,
+			teamsLoader:    newMockTeamsLoader(caseSensitiveTeamCollection, nil),
+			matchesLoader: newMockMatchesLoader(domain.MatchCollection{
+				{
+					Home:   domain.MatchCompetitor{Team: &domain.Team{ID: "PTFC"}},
+					Away:   domain.MatchCompetitor{Team: &domain.Team{ID: "SJRFC"}},
+					Winner: &domain.Team{ID: "PTFC"},
+				},
+			}, nil),
+			wantTournament: &domain.Tournament{
+				ID:       "TestTourney1",
+				Name:     "Test Tournament 1",
+				ImageURL: "http://tourney.jpg",
+				Teams:    caseSensitiveTeamCollection,
+				Matches: domain.MatchCollection{
+					{
+						Home:   domain.MatchCompetitor{Team: &domain.Team{ID: "PTFC", Name: "Plymouth Argyle", ImageURL: "http://ptfc.jpg"}},
+						Away:   domain.MatchCompetitor{Team: &domain.Team{ID: "SJRFC", Name: "St Johnstone", ImageURL: "http://sjrfc.jpg"}},
+						Winner: &domain.Team{ID: "PTFC", Name: "Plymouth Argyle", ImageURL: "http://ptfc.jpg"},
+					},
+				},
+				Template:               parseTemplate(t, "<h1>Hello World</h1>"),
+				CaseInsensitiveTeamIDs: true,
+			},
+		},
+		{
+			name:           "teams that exist by id with mismatched case must be enriched with canonical ids with case-insensitive team ids",
+			configFilename: "tournament_config_case_insensitive_team_ids.json",
+			markupFilename: tournamentMarkupOkFilename,
+			teamsLoader:    newMockTeamsLoader(caseSensitiveTeamCollection, nil),
+			matchesLoader: newMockMatchesLoader(domain.MatchCollection{
+				{
+					Home:   domain.MatchCompetitor{Team: &domain.Team{ID: "ptfc"}},
+					Away:   domain.MatchCompetitor{Team: &domain.Team{ID: "Sjrfc"}},
+					Winner: &domain.Team{ID: "ptfc"},
+				},
+			}, nil),
+			wantTournament: &domain.Tournament{
+				ID:       "TestTourney1",
+				Name:     "Test Tournament 1",
+				ImageURL: "http://tourney.jpg",
+				Teams:    caseSensitiveTeamCollection,
+				Matches: domain.MatchCollection{
+					{
+						Home:   domain.MatchCompetitor{Team: &domain.Team{ID: "PTFC", Name: "Plymouth Argyle", ImageURL: "http://ptfc.jpg"}}, // canonical id
+						Away:   domain.MatchCompetitor{Team: &domain.Team{ID: "SJRFC", Name: "St Johnstone", ImageURL: "http://sjrfc.jpg"}},  // canonical id
+						Winner: &domain.Team{ID: "PTFC", Name: "Plymouth Argyle", ImageURL: "http://ptfc.jpg"},                               // canonical id
+					},
+				},
+				Template:               parseTemplate(t, "<h1>Hello World</h1>"),
+				CaseInsensitiveTeamIDs: true,
+			},
+		},
+		{
+			name:           "teams that do not exist by id in any case must produce the expected error with case-insensitive team ids",
+			configFilename: "tournament_config_case_insensitive_team_ids.json",
+			markupFilename: tournamentMarkupOkFilename,
+			teamsLoader:    newMockTeamsLoader(caseSensitiveTeamCollection, nil),
+			matchesLoader: newMockMatchesLoader(domain.MatchCollection{
+				{
+					Home: domain.MatchCompetitor{Team: &domain.Team{ID: "ptfc"}},
+					Away: domain.MatchCompetitor{Team: &domain.Team{ID: "sjfc"}},
+				},
+			}, nil),
+			wantErr: newMultiError([]string{
+				"match 1: away: team id 'sjfc': not found",
+				"team id 'SJRFC': count 0",
+			}),
+		},
+		{
+			name:           "teams that are not accounted for within any matches must produce the expected error",
+			configFilename: tournamentConfigOkFilename,
+			markupFilename: tournamentMarkupOkFilename,
+			teamsLoader:    defaultMockTeamsLoader,
+			matchesLoader:  newMockMatchesLoader(domain.MatchCollection{}, nil),
+			wantErr: newMultiError([]string{
+				"team id '123': count 0", // first team in team collection
+				"team id '456': count 0", // second team in team collection
+			}),
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			var configPath, markupPath string
+			if tc.configFilename != "" {
+				configPath = filepath.Join(testdataDir, tournamentsDir, tc.configFilename)
+			}
+			if tc.markupFilename != "" {
+				markupPath = filepath.Join(testdataDir, tournamentsDir, tc.markupFilename)
+			}
+
+			loader := (&domain.TournamentFSLoader{}).
+				WithFileSystem(testdataFilesystem).
+				WithConfigPath(configPath).
+				WithMarkupPath(markupPath).
+				WithTeamsLoader(tc.teamsLoader).
+				WithMatchesLoader(tc.matchesLoader)
+
+			gotTournament, gotErr := loader.LoadTournament(ctx)
+
+			cmpError(t, tc.wantErr, gotErr)
+			cmpDiff(t, tc.wantTournament, gotTournament)
+		})
+	}
+}
+
+func TestTournamentFSLoader_LoadTournament_WithMarkupSource(t *testing.T) {
+	teams := domain.TeamCollection{
+		{ID: "123"}, {ID: "456"},
+	}
+
+	matches := domain.MatchCollection{
+		{
+			ID: "321",
+			Home: domain.MatchCompetitor{
+				Team: &domain.Team{ID: "123"},
+			},
+			Away: domain.MatchCompetitor{
+				Team: &domain.Team{ID: "456"},
+			},
+		},
+	}
+
+	inMemoryMarkup := func() ([]byte, error) {
+		return []byte("<h1>Hello Memory</h1>"), nil
+	}
+
+	tt := []struct {
+		name           string
+		markupFilename string
+		markupSource   domain.BytesFunc
+		wantTournament *domain.Tournament
+		wantErr        error
+	}{
+		{
+			name:         "markup from in-memory source must be loaded successfully",
+			markupSource: inMemoryMarkup,
+			wantTournament: &domain.Tournament{
+				ID:              "TestTourney1",
+				Name:            "Test Tournament 1",
+				ImageURL:        "http://tourney.jpg",
+				Teams:           teams,
+				Matches:         matches,
+				Template:        parseTemplate(t, "<h1>Hello Memory</h1>"),
+				WithLastUpdated: true,
+			},
+		},
+		{
+			name:           "markup source must take precedence over markup path",
+			markupFilename: tournamentMarkupOkFilename,
+			markupSource:   inMemoryMarkup,
+			wantTournament: &domain.Tournament{
+				ID:              "TestTourney1",
+				Name:            "Test Tournament 1",
+				ImageURL:        "http://tourney.jpg",
+				Teams:           teams,
+				Matches:         matches,
+				Template:        parseTemplate(t, "<h1>Hello Memory</h1>"),
+				WithLastUpdated: true,
+			},
+		},
+		{
+			name: "failure to read markup source must produce the expected error",
+			markupSource: func() ([]byte, error) {
+				return nil, errSadTimes
+			},
+			wantErr: errSadTimes,
+		},
+		{
+			name: "invalid markup must produce the expected error",
+			markupSource: func() ([]byte, error) {
+				return []byte("{{ .Unclosed"), nil
+			},
+			wantErr: errors.New("cannot parse template: template: tpl:1: unclosed action"),
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			var markupPath string
+			if tc.markupFilename != "" {
+				markupPath = filepath.Join(testdataDir, tournamentsDir, tc.markupFilename)
+			}
+
+			loader := (&domain.TournamentFSLoader{}).
+				WithFileSystem(testdataFilesystem).
+				WithConfigPath(filepath.Join(testdataDir, tournamentsDir, tournamentConfigOkFilename)).
+				WithMarkupPath(markupPath).
+				WithMarkupSource(tc.markupSource).
+				WithTeamsLoader(newMockTeamsLoader(teams, nil)).
+				WithMatchesLoader(newMockMatchesLoader(matches, nil))
+
+			gotTournament, gotErr := loader.LoadTournament(ctx)
+
+			cmpError(t, tc.wantErr, gotErr)
+			cmpDiff(t, tc.wantTournament, gotTournament)
+		})
+	}
+}
+
+func TestTournamentFSLoader_LoadTournament_WithOptionalMarkup(t *testing.T) {
+	teams := domain.TeamCollection{teamA, teamB}
+
+	matches := domain.MatchCollection{
+		{
+			ID:        "F",
+			Completed: true,
+			Home:      domain.MatchCompetitor{Team: teamA, Goals: 2},
+			Away:      domain.MatchCompetitor{Team: teamB, Goals: 1},
+			Winner:    teamA,
+		},
+	}
+
+	tt := []struct {
+		name           string
+		configFilename string
+		markupFilename string
+		markupSource   domain.BytesFunc
+		optional       bool
+		wantTournament *domain.Tournament
+		wantErr        error
+	}{
+		{
+			name:     "optional markup without markup source must be loaded without template",
+			optional: true,
+			wantTournament: &domain.Tournament{
+				ID:              "TestTourney1",
+				Name:            "Test Tournament 1",
+				ImageURL:        "http://tourney.jpg",
+				Teams:           teams,
+				Matches:         matches,
+				WithLastUpdated: true,
+			},
+		},
+		{
+			name:           "optional markup with non-existent markup path must be loaded without template",
+			markupFilename: "non-existent.gohtml",
+			optional:       true,
+			wantTournament: &domain.Tournament{
+				ID:              "TestTourney1",
+				Name:            "Test Tournament 1",
+				ImageURL:        "http://tourney.jpg",
+				Teams:           teams,
+				Matches:         matches,
+				WithLastUpdated: true,
+			},
+		},
+		{
+			name:           "optional markup that must be validated must be loaded without template if non-existent",
+			configFilename: "tournament_config_validate_markup.json",
+			markupFilename: "non-existent.gohtml",
+			optional:       true,
+			wantTournament: &domain.Tournament{
+				ID:             "TestTourney1",
+				Name:           "Test Tournament 1",
+				ImageURL:       "http://tourney.jpg",
+				Teams:          teams,
+				Matches:        matches,
+				ValidateMarkup: true,
+			},
+		},
+		{
+			name:           "optional markup with existing markup path must be loaded with template",
+			markupFilename: tournamentMarkupOkFilename,
+			optional:       true,
+			wantTournament: &domain.Tournament{
+				ID:              "TestTourney1",
+				Name:            "Test Tournament 1",
+				ImageURL:        "http://tourney.jpg",
+				Teams:           teams,
+				Matches:         matches,
+				Template:        parseTemplate(t, "<h1>Hello World</h1>"),
+				WithLastUpdated: true,
+			},
+		},
+		{
+			name: "optional markup with failing markup source must produce the expected error",
+			markupSource: func() ([]byte, error) {
+				return nil, errSadTimes
+			},
+			optional: true,
+			wantErr:  errSadTimes,
+		},
+		{
+			name:           "required markup with non-existent markup path must produce the expected error",
+			markupFilename: "non-existent.gohtml",
+			wantErr:        fs.ErrNotExist,
+		},
+		{
+			name:    "required markup without markup source must produce the expected error",
+			wantErr: domain.ErrIsEmpty,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			configFilename := tournamentConfigOkFilename
+			if tc.configFilename != "" {
+				configFilename = tc.configFilename
+			}
+
+			var markupPath string
+			if tc.markupFilename != "" {
+				markupPath = filepath.Join(testdataDir, tournamentsDir, tc.markupFilename)
+			}
+
+			loader := (&domain.TournamentFSLoader{}).
+				WithFileSystem(testdataFilesystem).
+				WithConfigPath(filepath.Join(testdataDir, tournamentsDir, configFilename)).
+				WithMarkupPath(markupPath).
+				WithMarkupSource(tc.markupSource).
+				WithOptionalMarkup(tc.optional).
+				WithTeamsLoader(newMockTeamsLoader(teams, nil)).
+				WithMatchesLoader(newMockMatchesLoader(matches, nil))
+
+			gotTournament, gotErr := loader.LoadTournament(context.Background())
+
+			cmpError(t, tc.wantErr, gotErr)
+			cmpDiff(t, tc.wantTournament, gotTournament)
+		})
+	}
+
+	t.Run("tournament without markup must produce prize json but not markup", func(t *testing.T) {
+		tournament, err := (&domain.TournamentFSLoader{}).
+			WithFileSystem(testdataFilesystem).
+			WithConfigPath(filepath.Join(testdataDir, tournamentsDir, tournamentConfigOkFilename)).
+			WithOptionalMarkup(true).
+			WithTeamsLoader(newMockTeamsLoader(teams, nil)).
+			WithMatchesLoader(newMockMatchesLoader(matches, nil)).
+			LoadTournament(context.Background())
+		cmpError(t, nil, err)
+
+		sweepstake := &domain.Sweepstake{
+			ID:           "test-sweepstake-1",
+			Tournament:   tournament,
+			Participants: domain.ParticipantCollection{participantA, participantB},
+			Prizes:       domain.PrizeSettings{Winner: true},
+		}
+
+		gotJSON, gotErr := sweepstake.GeneratePrizeJSON(time.Date(2018, 5, 26, 23, 30, 0, 0, time.UTC), false)
+		cmpError(t, nil, gotErr)
+		wantJSON := `{"sweepstake_id":"test-sweepstake-1","generated_at":"2018-05-26T23:30:00Z",` +
+			`"outright":[{"prize_name":"Tournament Winner","participant_name":"Marc Pugh (Team A)","image_url":"http://teamA.jpg"}],` +
+			`"ranked":[]}`
+		cmpDiff(t, wantJSON, string(gotJSON))
+
+		gotMarkup, gotErr := sweepstake.GenerateMarkup()
+		cmpError(t, errors.New("tournament 'TestTourney1' has no markup: template: is empty"), gotErr)
+		cmpDiff(t, []byte(nil), gotMarkup)
+	})
+}
+
+func TestTournamentFSLoader_LoadTournament_WithMarkupVariantPath(t *testing.T) {
+	teams := domain.TeamCollection{teamA, teamB}
+
+	matches := domain.MatchCollection{
+		{
+			ID:   "F",
+			Home: domain.MatchCompetitor{Team: teamA},
+			Away: domain.MatchCompetitor{Team: teamB},
+		},
+	}
+
+	tt := []struct {
+		name           string
+		variantSource  string
+		wantTournament *domain.Tournament
+		wantErr        error
+	}{
+		{
+			name:          "existing variant must be loaded",
+			variantSource: "tournament_markup_mobile.gohtml",
+			wantTournament: &domain.Tournament{
+				ID:       "TestTourney1",
+				Name:     "Test Tournament 1",
+				ImageURL: "http://tourney.jpg",
+				Teams:    teams,
+				Matches:  matches,
+				Template: parseTemplate(t, "<h1>Hello World</h1>"),
+				Variants: map[string]*template.Template{
+					"mobile": parseTemplate(t, "<p>{{ .Title }} (mobile)</p>\n"),
+				},
+				WithLastUpdated: true,
+			},
+		},
+		{
+			name:          "non-existent variant must be omitted",
+			variantSource: "non-existent.gohtml",
+			wantTournament: &domain.Tournament{
+				ID:              "TestTourney1",
+				Name:            "Test Tournament 1",
+				ImageURL:        "http://tourney.jpg",
+				Teams:           teams,
+				Matches:         matches,
+				Template:        parseTemplate(t, "<h1>Hello World</h1>"),
+				WithLastUpdated: true,
+			},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			loader := (&domain.TournamentFSLoader{}).
+				WithFileSystem(testdataFilesystem).
+				WithConfigPath(filepath.Join(testdataDir, tournamentsDir, tournamentConfigOkFilename)).
+				WithMarkupPath(filepath.Join(testdataDir, tournamentsDir, tournamentMarkupOkFilename)).
+				WithMarkupVariantPath("mobile", filepath.Join(testdataDir, tournamentsDir, tc.variantSource)).
+				WithTeamsLoader(newMockTeamsLoader(teams, nil)).
+				WithMatchesLoader(newMockMatchesLoader(matches, nil))
+
+			gotTournament, gotErr := loader.LoadTournament(context.Background())
+
+			cmpError(t, tc.wantErr, gotErr)
+			cmpDiff(t, tc.wantTournament, gotTournament)
+		})
+	}
+}
+
+func TestTournamentFSLoader_LoadTournament_WithParticipantMarkupPath(t *testing.T) {
+	teams := domain.TeamCollection{teamA, teamB}
+
+	matches := domain.MatchCollection{
+		{
+			ID:   "F",
+			Home: domain.MatchCompetitor{Team: teamA},
+			Away: domain.MatchCompetitor{Team: teamB},
+		},
+	}
+
+	tt := []struct {
+		name                    string
+		participantSource       string
+		wantParticipantTemplate *template.Template
+	}{
+		{
+			name:                    "existing participant markup must be loaded",
+			participantSource:       "tournament_markup_participant.gohtml",
+			wantParticipantTemplate: parseTemplate(t, "<h1>{{ .Title }}</h1>\n"),
+		},
+		{
+			name:              "non-existent participant markup must be omitted",
+			participantSource: "non-existent.gohtml",
+			// want nil participant template
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			loader := (&domain.TournamentFSLoader{}).
+				WithFileSystem(testdataFilesystem).
+				WithConfigPath(filepath.Join(testdataDir, tournamentsDir, tournamentConfigOkFilename)).
+				WithMarkupPath(filepath.Join(testdataDir, tournamentsDir, tournamentMarkupOkFilename)).
+				WithParticipantMarkupPath(filepath.Join(testdataDir, tournamentsDir, tc.participantSource)).
+				WithTeamsLoader(newMockTeamsLoader(teams, nil)).
+				WithMatchesLoader(newMockMatchesLoader(matches, nil))
+
+			gotTournament, gotErr := loader.LoadTournament(context.Background())
+
+			cmpError(t, nil, gotErr)
+			cmpDiff(t, tc.wantParticipantTemplate, gotTournament.ParticipantTemplate)
+		})
+	}
+}
+
+func TestTournamentFSLoader_LoadTournament_WithClock(t *testing.T) {
+	teams := domain.TeamCollection{
+		{ID: "123"}, {ID: "456"},
+	}
+
+	matches := domain.MatchCollection{
+		{
+			ID: "321",
+			Home: domain.MatchCompetitor{
+				Team: &domain.Team{ID: "123"},
+			},
+			Away: domain.MatchCompetitor{
+				Team: &domain.Team{ID: "456"},
+			},
+		},
+	}
+
+	clock := &fakeClock{Timestamp: time.Date(2018, 5, 26, 14, 0, 0, 0, time.UTC)}
+
+	tt := []struct {
+		name           string
+		clock          domain.Clock
+		wantTournament *domain.Tournament
+	}{
+		{
+			name:  "provided clock must be set on tournament",
+			clock: clock,
+			wantTournament: &domain.Tournament{
+				ID:              "TestTourney1",
+				Name:            "Test Tournament 1",
+				ImageURL:        "http://tourney.jpg",
+				Teams:           teams,
+				Matches:         matches,
+				Template:        parseTemplate(t, "<h1>Hello World</h1>"),
+				WithLastUpdated: true,
+				Clock:           clock,
+			},
+		},
+		{
+			name: "no clock must leave tournament clock empty",
+			// no clock
+			wantTournament: &domain.Tournament{
+				ID:              "TestTourney1",
+				Name:            "Test Tournament 1",
+				ImageURL:        "http://tourney.jpg",
+				Teams:           teams,
+				Matches:         matches,
+				Template:        parseTemplate(t, "<h1>Hello World</h1>"),
+				WithLastUpdated: true,
+			},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			loader := (&domain.TournamentFSLoader{}).
+				WithFileSystem(testdataFilesystem).
+				WithConfigPath(filepath.Join(testdataDir, tournamentsDir, tournamentConfigOkFilename)).
+				WithMarkupPath(filepath.Join(testdataDir, tournamentsDir, tournamentMarkupOkFilename)).
+				WithTeamsLoader(newMockTeamsLoader(teams, nil)).
+				WithMatchesLoader(newMockMatchesLoader(matches, nil)).
+				WithClock(tc.clock)
+
+			gotTournament, gotErr := loader.LoadTournament(context.Background())
+
+			cmpError(t, nil, gotErr)
+			cmpDiff(t, tc.wantTournament, gotTournament)
+		})
+	}
+}
+
+func TestTournamentBundleLoader_LoadTournament(t *testing.T) {
+	okBundle := filepath.Join(testdataDir, tournamentsDir, "bundle_ok")
+
+	// bundle must be loaded identically to wiring each of its files to the existing loaders
+	wantTournament, err := (&domain.TournamentFSLoader{}).
+		WithFileSystem(testdataFilesystem).
+		WithTeamsLoader((&domain.TeamsJSONLoader{}).
+			WithFileSystem(testdataFilesystem).
+			WithPath(filepath.Join(okBundle, "teams.json"))).
+		WithMatchesLoader((&domain.MatchesCSVLoader{}).
+			WithFileSystem(testdataFilesystem).
+			WithPath(filepath.Join(okBundle, "matches.csv")).
+			WithUpdatesPath(filepath.Join(okBundle, "matches_updates.csv"))).
+		WithConfigPath(filepath.Join(okBundle, "tournament.json")).
+		WithMarkupPath(filepath.Join(okBundle, "markup.gohtml")).
+		WithMarkupVariantPath("mobile", filepath.Join(okBundle, "markup_mobile.gohtml")).
+		WithParticipantMarkupPath(filepath.Join(okBundle, "markup_participant.gohtml")).
+		LoadTournament(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tt := []struct {
+		name           string
+		bundle         string
+		wantTournament *domain.Tournament
+		wantErr        error
+	}{
+		{
+			name:           "valid bundle must be loaded successfully",
+			bundle:         "bundle_ok",
+			wantTournament: wantTournament,
+		},
+		{
+			name:   "bundle with missing files must produce the expected error",
+			bundle: "bundle_missing_files",
+			wantErr: newMultiError([]string{
+				"bundle 'testdata/tournaments/bundle_missing_files': file 'matches.csv': not found",
+				"bundle 'testdata/tournaments/bundle_missing_files': file 'markup.gohtml': not found",
+			}),
+		},
+		{
+			name:   "non-existent bundle must produce the expected error",
+			bundle: "non-existent",
+			wantErr: newMultiError([]string{
+				"bundle 'testdata/tournaments/non-existent': file 'tournament.json': not found",
+				"bundle 'testdata/tournaments/non-existent': file 'teams.json': not found",
+				"bundle 'testdata/tournaments/non-existent': file 'matches.csv': not found",
+				"bundle 'testdata/tournaments/non-existent': file 'markup.gohtml': not found",
+			}),
+		},
+		{
+			name:    "empty path must produce the expected error",
+			wantErr: domain.ErrIsEmpty,
+			// bundle is empty
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			var path string
+			if tc.bundle != "" {
+				path = filepath.Join(testdataDir, tournamentsDir, tc.bundle)
+			}
+
+			loader := (&domain.TournamentBundleLoader{}).
+				WithFileSystem(testdataFilesystem).
+				WithPath(path)
+
+			gotTournament, gotErr := loader.LoadTournament(context.Background())
+			cmpError(t, tc.wantErr, gotErr)
+			cmpDiff(t, tc.wantTournament, gotTournament)
+		})
+	}
+
+	// optional files of a valid bundle must be discovered
+	gotTournament, err := (&domain.TournamentBundleLoader{}).
+		WithFileSystem(testdataFilesystem).
+		WithPath(okBundle).
+		LoadTournament(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cmpDiff(t, true, gotTournament.HasMarkupVariant("mobile"))
+	cmpDiff(t, false, gotTournament.HasMarkupVariant("participant"))
+	cmpDiff(t, parseTemplate(t, "<h1>{{ .Title }}</h1>\n"), gotTournament.ParticipantTemplate)
+	cmpDiff(t, true, gotTournament.Matches.GetByID("F").Completed) // applied by matches_updates.csv
+}
+
+func TestTournament_FinalMatch(t *testing.T) {
+	final := &domain.Match{ID: "F"}
+	customFinal := &domain.Match{ID: "M64"}
+	matches := domain.MatchCollection{{ID: "SF1"}, {ID: "SF2"}, final, customFinal}
+
+	tt := []struct {
+		name       string
+		tournament *domain.Tournament
+		wantMatch  *domain.Match
+	}{
+		{
+			name:       "tournament with default final match id must return final match",
+			tournament: &domain.Tournament{Matches: matches},
+			wantMatch:  final,
+		},
+		{
+			name: "tournament with custom final match id must return final match",
+			tournament: &domain.Tournament{
+				Matches:      matches,
+				FinalMatchID: "M64",
+			},
+			wantMatch: customFinal,
+		},
+		{
+			name: "tournament without final match must return nil",
+			tournament: &domain.Tournament{
+				Matches: domain.MatchCollection{{ID: "SF1"}, {ID: "SF2"}},
+			},
+			// want nil
+		},
+		{
+			name: "tournament without custom final match must return nil",
+			tournament: &domain.Tournament{
+				Matches:      matches,
+				FinalMatchID: "M65",
+			},
+			// want nil
+		},
+		{
+			name: "nil tournament must return nil",
+			// nil tournament
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			cmpDiff(t, tc.wantMatch, tc.tournament.FinalMatch())
+		})
+	}
+}
+
+func TestTournament_HasStaleMatches(t *testing.T) {
+	now := time.Date(2018, 5, 27, 18, 0, 0, 0, time.UTC)
+
+	stale := &domain.Match{ID: "A1", Timestamp: now.Add(-4 * time.Hour)}
+	fresh := &domain.Match{ID: "A2", Timestamp: now.Add(-2 * time.Hour)}
+	upcoming := &domain.Match{ID: "A3", Timestamp: now.Add(time.Hour)}
+	completed := &domain.Match{ID: "A4", Timestamp: now.Add(-48 * time.Hour), Completed: true}
+	noTimestamp := &domain.Match{ID: "A5"}
+
+	matches := domain.MatchCollection{stale, fresh, upcoming, completed, noTimestamp}
+
+	tt := []struct {
+		name       string
+		tournament *domain.Tournament
+		now        time.Time
+		wantStale  []*domain.Match
+	}{
+		{
+			name:       "matches that are not completed beyond the default stale match hours must be stale",
+			tournament: &domain.Tournament{Matches: matches},
+			now:        now,
+			wantStale:  []*domain.Match{stale},
+		},
+		{
+			name:       "matches that are not completed beyond the tournament's stale match hours must be stale",
+			tournament: &domain.Tournament{Matches: matches, StaleMatchHours: 1},
+			now:        now,
+			wantStale:  []*domain.Match{stale, fresh},
+		},
+		{
+			name:       "zero time must be compared against the tournament's clock",
+			tournament: &domain.Tournament{Matches: matches, Clock: &fakeClock{Timestamp: now.Add(-90 * time.Minute)}},
+			// want no stale matches, since the stale match kicked off 2.5 hours before the clock
+		},
+		{
+			name: "nil tournament must return no matches",
+			now:  now,
+			// want no stale matches
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			gotStale := tc.tournament.HasStaleMatches(tc.now)
+			cmpDiff(t, tc.wantStale, gotStale)
+		})
+	}
+}
+
+func TestTournament_MatchPlayed(t *testing.T) {
+	now := time.Date(2018, 5, 26, 15, 0, 0, 0, time.UTC)
+
+	match := &domain.Match{Timestamp: now}
+
+	tt := []struct {
+		name       string
+		tournament *domain.Tournament
+		wantPlayed bool
+	}{
+		{
+			name:       "match must be played relative to the tournament's clock",
+			tournament: &domain.Tournament{Clock: &fakeClock{Timestamp: now}},
+			wantPlayed: true,
+		},
+		{
+			name:       "match must not be played before the tournament's clock reaches kick-off",
+			tournament: &domain.Tournament{Clock: &fakeClock{Timestamp: now.Add(-time.Minute)}},
+			wantPlayed: false,
+		},
+		{
+			name:       "tournament without clock must compare against the system time",
+			tournament: &domain.Tournament{},
+			wantPlayed: true,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			cmpDiff(t, tc.wantPlayed, tc.tournament.MatchPlayed(match))
+		})
+	}
+}
+
+func TestTournament_TotalAttendance(t *testing.T) {
+	matchesWithoutAttendance, err := newMatchesCSVLoader("matches_ok.csv").LoadMatches(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tt := []struct {
+		name       string
+		tournament *domain.Tournament
+		wantTotal  int
+	}{
+		{
+			name: "attendance of each match must be combined",
+			tournament: &domain.Tournament{
+				Matches: domain.MatchCollection{
+					{ID: "A1", Attendance: 40000},
+					{ID: "A2"}, // unknown attendance
+					{ID: "A3", Attendance: 1234},
+				},
+			},
+			wantTotal: 41234,
+		},
+		{
+			name:       "matches loaded from a file without an attendance column must produce zero total",
+			tournament: &domain.Tournament{Matches: matchesWithoutAttendance},
+			wantTotal:  0,
+		},
+		{
+			name:      "nil tournament must produce zero total",
+			wantTotal: 0,
+			// nil tournament
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			cmpDiff(t, tc.wantTotal, tc.tournament.TotalAttendance())
+		})
+	}
+}
+
+func TestTournament_BestAttendedMatch(t *testing.T) {
+	matchA1 := &domain.Match{ID: "A1", Attendance: 40000}
+	matchA2 := &domain.Match{ID: "A2"}
+	matchA3 := &domain.Match{ID: "A3", Attendance: 60000}
+	matchA4 := &domain.Match{ID: "A4", Attendance: 60000}
+
+	tt := []struct {
+		name       string
+		tournament *domain.Tournament
+		wantMatch  *domain.Match
+	}{
+		{
+			name: "match with highest attendance must be returned",
+			tournament: &domain.Tournament{
+				Matches: domain.MatchCollection{matchA1, matchA2, matchA3},
+			},
+			wantMatch: matchA3,
+		},
+		{
+			name: "identical attendance must return first match",
+			tournament: &domain.Tournament{
+				Matches: domain.MatchCollection{matchA1, matchA3, matchA4},
+			},
+			wantMatch: matchA3,
+		},
+		{
+			name: "no known attendance must return nil",
+			tournament: &domain.Tournament{
+				Matches: domain.MatchCollection{matchA2},
+			},
+			// want nil match
+		},
+		{
+			name: "nil tournament must return nil",
+			// nil tournament, want nil match
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			cmpDiff(t, tc.wantMatch, tc.tournament.BestAttendedMatch())
+		})
+	}
+}
+
+func TestTournament_TopScoringTeam(t *testing.T) {
+	teams := domain.TeamCollection{teamA, teamB, teamC, teamD}
+
+	newMatch := func(completed bool, home *domain.Team, homeGoals uint8, away *domain.Team, awayGoals uint8) *domain.Match {
+		return &domain.Match{
+			Completed: completed,
+			Home:      domain.MatchCompetitor{Team: home, Goals: homeGoals},
+			Away:      domain.MatchCompetitor{Team: away, Goals: awayGoals},
+		}
+	}
+
+	tt := []struct {
+		name       string
+		tournament *domain.Tournament
+		wantTeam   *domain.Team
+	}{
+		{
+			name: "team with most goals must be returned",
+			tournament: &domain.Tournament{
+				Teams: teams,
+				Matches: domain.MatchCollection{
+					newMatch(true, teamA, 1, teamB, 3),  // teamA = 1, teamB = 3
+					newMatch(true, teamC, 2, teamD, 2),  // teamC = 2, teamD = 2
+					newMatch(true, teamB, 1, teamC, 0),  // teamB = 4
+					newMatch(false, teamC, 9, teamA, 0), // not completed, should be ignored
 				},
+			},
+			wantTeam: teamB,
+		},
+		{
+			name: "identical goals must return team that conceded fewest",
+			tournament: &domain.Tournament{
+				Teams: teams,
 				Matches: domain.MatchCollection{
-					{
-						Home:   domain.MatchCompetitor{Team: &domain.Team{ID: "123", Name: "Team123", ImageURL: "http://team123.jpg"}}, // fully-enriched team
-						Away:   domain.MatchCompetitor{Team: &domain.Team{ID: "456", Name: "Team456", ImageURL: "http://team456.jpg"}}, // fully-enriched team
-						Winner: &domain.Team{ID: "123", Name: "Team123", ImageURL: "http://team123.jpg"},                               // fully-enriched team
-					},
+					newMatch(true, teamA, 3, teamB, 2), // teamA = 3 (2 conceded)
+					newMatch(true, teamC, 3, teamD, 0), // teamC = 3 (0 conceded)
 				},
-				Template:        parseTemplate(t, "<h1>Hello World</h1>"),
-				WithLastUpdated: true,
 			},
+			wantTeam: teamC,
 		},
 		{
-			name:           "teams that do not exist by id must produce the expected error",
-			configFilename: tournamentConfigOkFilename,
-			markupFilename: tournamentMarkupOkFilename,
-			teamsLoader:    defaultMockTeamsLoader,
-			matchesLoader: newMockMatchesLoader(domain.MatchCollection{
-				{
-					Home: domain.MatchCompetitor{Team: &domain.Team{ID: "123"}}, // included in default team collection
-					Away: domain.MatchCompetitor{Team: &domain.Team{ID: "456"}}, // included in default team collection
+			name: "identical goals and conceded must return team by name",
+			tournament: &domain.Tournament{
+				Teams: teams,
+				Matches: domain.MatchCollection{
+					newMatch(true, teamD, 2, teamB, 1), // teamD = 2 (1 conceded)
+					newMatch(true, teamC, 2, teamA, 1), // teamC = 2 (1 conceded)
 				},
-				{
-					Home:   domain.MatchCompetitor{Team: &domain.Team{ID: "AAA"}},
-					Away:   domain.MatchCompetitor{Team: &domain.Team{ID: "BBB"}},
-					Winner: &domain.Team{ID: "CCC"},
+			},
+			wantTeam: teamC,
+		},
+		{
+			name: "no completed matches must return nil",
+			tournament: &domain.Tournament{
+				Teams: teams,
+				Matches: domain.MatchCollection{
+					newMatch(false, teamA, 1, teamB, 0),
 				},
-			}, nil),
-			wantErr: newMultiError([]string{
-				"match 2: home: team id 'AAA': not found",
-				"match 2: away: team id 'BBB': not found",
-				"match 2: winner: team id 'CCC': not found",
-			}),
+			},
+			// want nil team
 		},
 		{
-			name:           "teams that are not accounted for within any matches must produce the expected error",
-			configFilename: tournamentConfigOkFilename,
-			markupFilename: tournamentMarkupOkFilename,
-			teamsLoader:    defaultMockTeamsLoader,
-			matchesLoader:  newMockMatchesLoader(domain.MatchCollection{}, nil),
-			wantErr: newMultiError([]string{
-				"team id '123': count 0", // first team in team collection
-				"team id '456': count 0", // second team in team collection
-			}),
+			name: "nil tournament must return nil",
+			// nil tournament, want nil team
 		},
 	}
 
 	for _, tc := range tt {
 		t.Run(tc.name, func(t *testing.T) {
-			ctx := context.Background()
+			cmpDiff(t, tc.wantTeam, tc.tournament.TopScoringTeam())
+		})
+	}
+}
 
-			var configPath, markupPath string
-			if tc.configFilename != "" {
-				configPath = filepath.Join(testdataDir, tournamentsDir, tc.configFilename)
-			}
-			if tc.markupFilename != "" {
-				markupPath = filepath.Join(testdataDir, tournamentsDir, tc.markupFilename)
-			}
+func TestTournament_KnockoutRounds(t *testing.T) {
+	kickOff := time.Date(2018, 5, 26, 14, 0, 0, 0, time.UTC)
 
-			loader := (&domain.TournamentFSLoader{}).
-				WithFileSystem(testdataFilesystem).
-				WithConfigPath(configPath).
-				WithMarkupPath(markupPath).
-				WithTeamsLoader(tc.teamsLoader).
-				WithMatchesLoader(tc.matchesLoader)
+	newMatch := func(id string, stage domain.MatchStage, daysAfterKickOff int) *domain.Match {
+		return &domain.Match{ID: id, Stage: stage, Timestamp: kickOff.AddDate(0, 0, daysAfterKickOff)}
+	}
 
-			gotTournament, gotErr := loader.LoadTournament(ctx)
+	groupA1 := newMatch("A1", domain.GroupStage, 0)
+	groupA2 := newMatch("A2", domain.GroupStage, 1)
+	semiFinal1 := newMatch("SF1 [49]", domain.KnockoutStage, 6)
+	semiFinal2 := newMatch("SF2 [50]", domain.KnockoutStage, 5)
+	final := newMatch("F", domain.KnockoutStage, 10)
 
-			cmpError(t, tc.wantErr, gotErr)
-			cmpDiff(t, tc.wantTournament, gotTournament)
+	tt := []struct {
+		name       string
+		tournament *domain.Tournament
+		wantRounds []domain.KnockoutRound
+	}{
+		{
+			name: "two-round knockout must be grouped by round in order of timestamp",
+			tournament: &domain.Tournament{
+				Matches: domain.MatchCollection{groupA1, final, semiFinal1, groupA2, semiFinal2},
+			},
+			wantRounds: []domain.KnockoutRound{
+				{Name: "SF", Matches: domain.MatchCollection{semiFinal2, semiFinal1}},
+				{Name: "F", Matches: domain.MatchCollection{final}},
+			},
+		},
+		{
+			name: "numbered rounds must retain their number",
+			tournament: &domain.Tournament{
+				Matches: domain.MatchCollection{
+					newMatch("R16_1 [37]", domain.KnockoutStage, 2),
+					newMatch("R16-2", domain.KnockoutStage, 2),
+					newMatch("R16", domain.KnockoutStage, 3),
+					newMatch("QF1", domain.KnockoutStage, 4),
+				},
+			},
+			wantRounds: []domain.KnockoutRound{
+				{Name: "R16", Matches: domain.MatchCollection{
+					newMatch("R16_1 [37]", domain.KnockoutStage, 2),
+					newMatch("R16-2", domain.KnockoutStage, 2),
+					newMatch("R16", domain.KnockoutStage, 3),
+				}},
+				{Name: "QF", Matches: domain.MatchCollection{newMatch("QF1", domain.KnockoutStage, 4)}},
+			},
+		},
+		{
+			name: "no knockout matches must return empty",
+			tournament: &domain.Tournament{
+				Matches: domain.MatchCollection{groupA1, groupA2},
+			},
+			wantRounds: []domain.KnockoutRound{},
+		},
+		{
+			name:       "nil tournament must return empty",
+			wantRounds: []domain.KnockoutRound{},
+			// nil tournament
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			cmpDiff(t, tc.wantRounds, tc.tournament.KnockoutRounds())
 		})
 	}
 }
@@ -292,6 +1558,64 @@ func TestTournamentCollection_GetByID(t *testing.T) {
 	}
 }
 
+func TestTournamentCollection_Merge(t *testing.T) {
+	collection := domain.TournamentCollection{
+		{ID: "tournament1"},
+		{ID: "tournament2"},
+	}
+
+	tt := []struct {
+		name           string
+		other          domain.TournamentCollection
+		wantCollection domain.TournamentCollection
+		wantErr        error
+	}{
+		{
+			name: "collections with distinct ids must be merged successfully",
+			other: domain.TournamentCollection{
+				{ID: "tournament3"},
+				{ID: "tournament4"},
+			},
+			wantCollection: domain.TournamentCollection{
+				{ID: "tournament1"},
+				{ID: "tournament2"},
+				{ID: "tournament3"},
+				{ID: "tournament4"},
+			},
+		},
+		{
+			name: "empty collection must be merged successfully",
+			wantCollection: domain.TournamentCollection{
+				{ID: "tournament1"},
+				{ID: "tournament2"},
+			},
+			// other is empty
+		},
+		{
+			name: "id that appears in both collections must produce the expected error",
+			other: domain.TournamentCollection{
+				{ID: "tournament3"},
+				{ID: "tournament1"},
+			},
+			wantErr: newMultiError([]string{
+				"id 'tournament1': is duplicate",
+			}),
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			gotCollection, gotErr := collection.Merge(tc.other)
+
+			cmpError(t, tc.wantErr, gotErr)
+			cmpDiff(t, tc.wantCollection, gotCollection)
+
+			// original collection must not be modified
+			cmpDiff(t, domain.TournamentCollection{{ID: "tournament1"}, {ID: "tournament2"}}, collection)
+		})
+	}
+}
+
 func TestNewTournamentCollection(t *testing.T) {
 	tt := []struct {
 		name           string
@@ -372,6 +1696,37 @@ func TestNewTournamentCollection(t *testing.T) {
 	}
 }
 
+var bracketTeamCollection = domain.TeamCollection{
+	{ID: "000"}, {ID: "123"}, {ID: "456"}, {ID: "789"},
+}
+
+var caseSensitiveTeamCollection = domain.TeamCollection{
+	{ID: "PTFC", Name: "Plymouth Argyle", ImageURL: "http://ptfc.jpg"},
+	{ID: "SJRFC", Name: "St Johnstone", ImageURL: "http://sjrfc.jpg"},
+}
+
+func newBracketMatch(id string, stage domain.MatchStage, homeTeamID, awayTeamID, winnerTeamID string) *domain.Match {
+	return &domain.Match{
+		ID:        id,
+		Stage:     stage,
+		Home:      domain.MatchCompetitor{Team: &domain.Team{ID: homeTeamID}},
+		Away:      domain.MatchCompetitor{Team: &domain.Team{ID: awayTeamID}},
+		Winner:    &domain.Team{ID: winnerTeamID},
+		Completed: true,
+	}
+}
+
+func mustLoadLocation(t *testing.T, name string) *time.Location {
+	t.Helper()
+
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return loc
+}
+
 type mockTeamsLoader struct {
 	teams domain.TeamCollection
 	err   error
@@ -419,3 +1774,12 @@ func newMockTournamentLoader(tournament *domain.Tournament, err error) *mockTour
 		err:        err,
 	}
 }
+
+// fakeClock provides a fixed timestamp as the current time
+type fakeClock struct {
+	Timestamp time.Time
+}
+
+func (f *fakeClock) Now() time.Time {
+	return f.Timestamp
+}
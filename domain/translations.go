@@ -0,0 +1,132 @@
+package domain
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"sort"
+	"strings"
+)
+
+// defaultLang defines the language of the labels that are rendered as-is, without translation
+const defaultLang = "en"
+
+// Translations provides the translation of each label (keyed by its english label, e.g. "TBC") within each language (keyed by language code, e.g. "fr")
+type Translations map[string]map[string]string
+
+// Translate returns the translation of the provided label within the provided language, and true if the translation exists
+//
+// The default language (english) always returns the label as-is
+func (t Translations) Translate(lang, label string) (string, bool) {
+	if isDefaultLang(lang) {
+		return label, true
+	}
+
+	translated, ok := t[lang][label]
+	if !ok {
+		return label, false
+	}
+
+	return translated, true
+}
+
+// hasLang returns true if the provided language is the default language, or is provided by the translations
+func (t Translations) hasLang(lang string) bool {
+	if isDefaultLang(lang) {
+		return true
+	}
+
+	_, ok := t[lang]
+	return ok
+}
+
+// isDefaultLang returns true if the provided language is empty or represents the default language
+func isDefaultLang(lang string) bool {
+	return lang == "" || strings.EqualFold(lang, defaultLang)
+}
+
+type TranslationsJSONLoader struct {
+	fSys fs.FS
+	path string
+}
+
+func (t *TranslationsJSONLoader) WithFileSystem(fSys fs.FS) *TranslationsJSONLoader {
+	t.fSys = fSys
+	return t
+}
+
+func (t *TranslationsJSONLoader) WithPath(path string) *TranslationsJSONLoader {
+	t.path = path
+	return t
+}
+
+func (t *TranslationsJSONLoader) init() error {
+	if t.fSys == nil {
+		t.fSys = defaultFileSystem
+	}
+
+	if t.path == "" {
+		return fmt.Errorf("path: %w", ErrIsEmpty)
+	}
+
+	return nil
+}
+
+func (t *TranslationsJSONLoader) LoadTranslations(_ context.Context) (Translations, error) {
+	if err := t.init(); err != nil {
+		return nil, err
+	}
+
+	// read translations file
+	b, err := readFile(t.fSys, t.path)
+	if err != nil {
+		return nil, err
+	}
+
+	// parse file contents
+	var content = &struct {
+		Translations Translations `json:"translations"`
+	}{}
+	if err = json.Unmarshal(b, &content); err != nil {
+		return nil, fmt.Errorf("cannot unmarshal translations: %w", err)
+	}
+
+	return validateTranslations(content.Translations)
+}
+
+func validateTranslations(translations Translations) (Translations, error) {
+	mErr := NewMultiError()
+
+	// guarantee error order
+	langs := make([]string, 0, len(translations))
+	for lang := range translations {
+		langs = append(langs, lang)
+	}
+	sort.Strings(langs)
+
+	for _, lang := range langs {
+		if strings.Trim(lang, " ") == "" {
+			mErr.Add(fmt.Errorf("lang: %w", ErrIsEmpty))
+			continue
+		}
+
+		labels := make([]string, 0, len(translations[lang]))
+		for label := range translations[lang] {
+			labels = append(labels, label)
+		}
+		sort.Strings(labels)
+
+		for _, label := range labels {
+			if strings.Trim(translations[lang][label], " ") == "" {
+				mErr.Add(fmt.Errorf("lang '%s': label '%s': translation: %w", lang, label, ErrIsEmpty))
+			}
+		}
+	}
+
+	if !mErr.IsEmpty() {
+		return nil, mErr
+	}
+
+	return translations, nil
+}
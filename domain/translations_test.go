@@ -0,0 +1,122 @@
+package domain_test
+
+import (
+	"io/fs"
+	"path/filepath"
+	"testing"
+
+	"github.com/sweepstake-markup-generator/domain"
+)
+
+func TestTranslations_Translate(t *testing.T) {
+	translations := domain.Translations{
+		"fr": {"TBC": "À confirmer"},
+	}
+
+	tt := []struct {
+		name           string
+		lang           string
+		label          string
+		wantTranslated string
+		wantOK         bool
+	}{
+		{
+			name:           "label within language must return translation",
+			lang:           "fr",
+			label:          "TBC",
+			wantTranslated: "À confirmer",
+			wantOK:         true,
+		},
+		{
+			name:           "label without translation must return label",
+			lang:           "fr",
+			label:          "Tournament Winner",
+			wantTranslated: "Tournament Winner",
+		},
+		{
+			name:           "label within unknown language must return label",
+			lang:           "de",
+			label:          "TBC",
+			wantTranslated: "TBC",
+		},
+		{
+			name:           "label within default language must return label",
+			lang:           "en",
+			label:          "TBC",
+			wantTranslated: "TBC",
+			wantOK:         true,
+		},
+		{
+			name:           "label within empty language must return label",
+			label:          "TBC",
+			wantTranslated: "TBC",
+			wantOK:         true,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			gotTranslated, gotOK := translations.Translate(tc.lang, tc.label)
+			cmpDiff(t, tc.wantTranslated, gotTranslated)
+			cmpDiff(t, tc.wantOK, gotOK)
+		})
+	}
+}
+
+func TestTranslationsJSONLoader_LoadTranslations(t *testing.T) {
+	tt := []struct {
+		name             string
+		testFile         string
+		wantTranslations domain.Translations
+		wantErr          error
+	}{
+		{
+			name:     "valid translations must be loaded successfully",
+			testFile: "translations_ok.json",
+			wantTranslations: domain.Translations{
+				"fr": {
+					"TBC":               "À confirmer",
+					"Tournament Winner": "Vainqueur du tournoi",
+				},
+				"es": {
+					"TBC": "Por confirmar",
+				},
+			},
+		},
+		{
+			name:     "empty language and translation must produce the expected error",
+			testFile: "translations_empty_translation.json",
+			wantErr: newMultiError([]string{
+				"lang: is empty",
+				"lang 'fr': label 'TBC': translation: is empty",
+			}),
+		},
+		{
+			name:    "empty path must produce the expected error",
+			wantErr: domain.ErrIsEmpty,
+			// testFile is empty
+		},
+		{
+			name:     "non-existent path must produce the expected error",
+			testFile: "non-existent.json",
+			wantErr:  fs.ErrNotExist,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			var path string
+			if tc.testFile != "" {
+				path = filepath.Join(testdataDir, translationsDir, tc.testFile)
+			}
+
+			loader := (&domain.TranslationsJSONLoader{}).
+				WithFileSystem(testdataFilesystem).
+				WithPath(path)
+
+			gotTranslations, gotErr := loader.LoadTranslations(nil)
+			cmpError(t, tc.wantErr, gotErr)
+			cmpDiff(t, tc.wantTranslations, gotTranslations)
+		})
+	}
+}
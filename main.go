@@ -1,11 +1,25 @@
 package main
 
 import (
+	"archive/zip"
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"html/template"
+	"io"
 	"io/fs"
 	"log"
+	"net/http"
 	"os"
+	"path"
 	"path/filepath"
+	"regexp"
+	"strings"
 	"time"
 
 	"github.com/joho/godotenv"
@@ -22,6 +36,11 @@ var (
 func main() {
 	log.SetFlags(log.LstdFlags | log.Lshortfile)
 
+	validate := flag.Bool("validate", false, "validate all tournaments and sweepstakes, reporting every problem without writing any files")
+	plan := flag.String("plan", "", "path to a previously deployed site, which is compared against the generated files without writing them")
+	failOnChange := flag.Bool("fail-on-change", false, "exit with a non-zero status if the plan contains any changes")
+	flag.Parse()
+
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
@@ -33,84 +52,307 @@ func main() {
 
 	// parse env
 	var config struct {
-		SweepstakesURL       string `envconfig:"SWEEPSTAKES_URL"`
-		SweepstakesBasicAuth string `envconfig:"SWEEPSTAKES_BASICAUTH"`
+		SweepstakesURL       string            `envconfig:"SWEEPSTAKES_URL"`
+		SweepstakesBasicAuth string            `envconfig:"SWEEPSTAKES_BASICAUTH"`
+		SweepstakesUser      string            `envconfig:"SWEEPSTAKES_USER"`
+		SweepstakesPass      string            `envconfig:"SWEEPSTAKES_PASS"`
+		SweepstakesMaxBytes  int64             `envconfig:"SWEEPSTAKES_MAX_BYTES"`
+		SweepstakesHeaders   map[string]string `envconfig:"SWEEPSTAKES_HEADERS"`
+		Verbose              bool              `envconfig:"VERBOSE"`
+		AllowMissingImages   bool              `envconfig:"ALLOW_MISSING_IMAGES"`
+		OutputCharsetMeta    bool              `envconfig:"OUTPUT_CHARSET_META"`
+		OutputBOM            string            `envconfig:"OUTPUT_BOM"`
+		OutputExtension      string            `envconfig:"OUTPUT_EXTENSION"`
+		PrettyJSON           bool              `envconfig:"PRETTY_JSON"`
+		PrizesGeneratedAt    bool              `envconfig:"PRIZES_GENERATED_AT"`
+		BaseURL              string            `envconfig:"BASE_URL"`
+		URLPathPrefix        string            `envconfig:"URL_PATH_PREFIX"`
+		NotFoundPage         bool              `envconfig:"NOT_FOUND_PAGE"`
+		NotFoundMessage      string            `envconfig:"NOT_FOUND_MESSAGE"`
+		IncrementalBuild     bool              `envconfig:"INCREMENTAL_BUILD"`
+		BuildStatePath       string            `envconfig:"BUILD_STATE_PATH"`
+		EnablePrizes         []string          `envconfig:"ENABLE_PRIZES"`
+		ParticipantPages     bool              `envconfig:"PARTICIPANT_PAGES"`
+		OutputZip            string            `envconfig:"OUTPUT_ZIP"`
+		OutputZipOnly        bool              `envconfig:"OUTPUT_ZIP_ONLY"`
 	}
 	envconfig.MustProcess("", &config)
 
+	output := outputOptions{
+		charsetMeta: config.OutputCharsetMeta,
+		bom:         bomMode(config.OutputBOM),
+		extension:   config.OutputExtension,
+	}
+	if err := output.validate(); err != nil {
+		log.Fatal(err)
+	}
+
+	// generated links are relative to the url that the site is hosted at, including any path prefix
+	siteURL, err := withURLPathPrefix(config.BaseURL, config.URLPathPrefix)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	source := "sweepstakes.json"
+	bytesFn := domain.BytesFromFileSystem(defaultFilesystem, source)
+
+	if config.SweepstakesURL != "" {
+		source = config.SweepstakesURL
+		basicAuth := composeBasicAuth(config.SweepstakesUser, config.SweepstakesPass, config.SweepstakesBasicAuth)
+		header := http.Header{}
+		for key, value := range config.SweepstakesHeaders {
+			header.Set(key, value)
+		}
+		bytesFn = domain.BytesFromURL(source, basicAuth, nil, domain.MaxResponseBytes(config.SweepstakesMaxBytes), domain.RequestHeaders(header))
+	}
+
+	if *validate {
+		log.Printf("validating tournaments and sweepstakes from %s...", source)
+		warnings := domain.NewMultiError()
+		mErr := validateConfig(ctx, defaultFilesystem, bytesFn, warnings)
+		if !warnings.IsEmpty() {
+			log.Printf("warning: %s", warnings.Error())
+		}
+		if !mErr.IsEmpty() {
+			log.Fatalf("validation failed: %s", mErr.Error())
+		}
+		log.Println("success! no problems found")
+		return
+	}
+
+	// generate the site in a temporary directory if planning, so that it can be compared against the deployed site
+	if *plan != "" {
+		tmpDir, err := os.MkdirTemp("", "sweepstake-plan-*")
+		if err != nil {
+			log.Fatalf("cannot create plan directory: %s", err.Error())
+		}
+		defer os.RemoveAll(tmpDir)
+
+		siteDir = tmpDir
+		config.IncrementalBuild = false // every file must be generated to be compared
+	}
+
+	// write the site to its directory, and also (or only) to a zip archive if requested, unless planning
+	var site siteWriter = dirWriter{root: siteDir}
+	var archive *zipWriter
+	var archiveFile *os.File
+	if config.OutputZip != "" && *plan == "" {
+		var err error
+		if archiveFile, err = os.Create(config.OutputZip); err != nil {
+			log.Fatalf("cannot create zip archive: %s", err.Error())
+		}
+		defer archiveFile.Close()
+
+		archive = newZipWriter(archiveFile)
+		site = multiSiteWriter{site, archive}
+		if config.OutputZipOnly {
+			site = archive
+		}
+		config.IncrementalBuild = false // every file must be generated to be archived
+	}
+
+	// record the hash of each input, so that sweepstakes with unchanged inputs can be skipped
+	var prevState, state buildState
+	if config.IncrementalBuild {
+		if config.BuildStatePath == "" {
+			config.BuildStatePath = defaultBuildStatePath
+		}
+
+		var err error
+		if prevState, err = loadBuildState(config.BuildStatePath); err != nil {
+			log.Fatalf("cannot load build state: %s", err.Error())
+		}
+		state.Tournaments = make(map[string]string)
+
+		srcFn := bytesFn
+		bytesFn = func() ([]byte, error) {
+			b, err := srcFn()
+			state.Sweepstakes = hashBytes(b)
+			return b, err
+		}
+
+		if b, err := fs.ReadFile(defaultFilesystem, translationsPath); err == nil {
+			state.Translations = hashBytes(b)
+		}
+
+		state.Output = outputSettings{
+			CharsetMeta:       config.OutputCharsetMeta,
+			BOM:               config.OutputBOM,
+			Extension:         config.OutputExtension,
+			SiteURL:           siteURL,
+			EnablePrizes:      config.EnablePrizes,
+			ParticipantPages:  config.ParticipantPages,
+			PrettyJSON:        config.PrettyJSON,
+			PrizesGeneratedAt: config.PrizesGeneratedAt,
+		}.hash()
+	}
+
+	// collect warnings instead of failing if lenient about missing images
+	var warnings domain.MultiError
+	if config.AllowMissingImages {
+		warnings = domain.NewMultiError()
+	}
+
+	phaseTimer := newTimer(nil)
+
 	// load tournaments from filesystem
 	tournaments := make(domain.TournamentCollection, 0)
 	if err := fs.WalkDir(defaultFilesystem, "tournaments", func(path string, d fs.DirEntry, err error) error {
 		if !d.IsDir() || path == "tournaments" {
 			return nil
 		}
-		tournaments = append(tournaments, mustLoadTournamentFromPath(ctx, path))
+		tournamentTimer := newTimer(nil)
+		tournament := mustLoadTournamentFromPath(ctx, path, warnings)
+		tournaments = append(tournaments, tournament)
+		if config.IncrementalBuild {
+			hash, err := hashTournamentInputs(defaultFilesystem, path)
+			if err != nil {
+				log.Fatalf("cannot hash inputs of tournament '%s': %s", path, err.Error())
+			}
+			state.Tournaments[tournament.ID] = hash
+		}
+		if config.Verbose {
+			log.Println(tournamentTimer.lap(fmt.Sprintf("loading tournament '%s'", path)))
+		}
 		return err
 	}); err != nil {
 		log.Fatal(err)
 	}
+	log.Println(phaseTimer.lap("loading tournaments"))
 
-	source := "sweepstakes.json"
-	bytesFn := domain.BytesFromFileSystem(defaultFilesystem, source)
+	if warnings != nil && !warnings.IsEmpty() {
+		log.Printf("warning: %s", warnings.Error())
+	}
 
-	if config.SweepstakesURL != "" {
-		source = config.SweepstakesURL
-		bytesFn = domain.BytesFromURL(source, config.SweepstakesBasicAuth, nil)
+	// warn of matches whose results have not been entered
+	staleWarnings := domain.NewMultiError()
+	addStaleMatchWarnings(tournaments, time.Now(), staleWarnings)
+	if !staleWarnings.IsEmpty() {
+		log.Printf("warning: %s", staleWarnings.Error())
+	}
+
+	translations, err := loadTranslations(ctx, defaultFilesystem)
+	if err != nil {
+		log.Fatalf("cannot load translations: %s", err.Error())
 	}
 
 	log.Printf("retrieving sweepstakes from %s...", source)
 
 	// load sweepstakes
-	sweepstakes, err := (&domain.SweepstakesJSONLoader{}).
+	sweepstakes, report, err := (&domain.SweepstakesJSONLoader{}).
 		WithSource(bytesFn).
 		WithTournamentCollection(tournaments).
-		LoadSweepstakes(ctx)
+		WithEnabledPrizes(config.EnablePrizes).
+		WithTranslations(translations).
+		LoadSweepstakesWithReport(ctx)
 	if err != nil {
 		log.Fatal(err)
 	}
+	log.Println(phaseTimer.lap("loading sweepstakes"))
+	if config.Verbose {
+		log.Printf("loaded sweepstakes: %d, participants: %d, tournaments referenced: %d", report.Sweepstakes, report.Participants, report.Tournaments)
+	}
 
-	// write markup for each sweepstake
-	var skipped int
+	// only stamp prizes.json with the time of the build if requested, since a stamped file changes on every build
+	var prizesGeneratedAt time.Time
+	if config.PrizesGeneratedAt {
+		prizesGeneratedAt = time.Now()
+	}
+
+	// determine which sweepstakes to build
+	var skipped, unchanged int
+	var build domain.SweepstakeCollection
 	for _, sweepstake := range sweepstakes {
 		if !sweepstake.Build {
 			skipped++
 			continue
 		}
-		mustWriteSweepstakeMarkup(sweepstake)
+		if config.IncrementalBuild && state.isUnchanged(prevState, sweepstake.Tournament.ID) && hasSweepstakeOutput(site, sweepstake, output, config.ParticipantPages) {
+			unchanged++
+			if config.Verbose {
+				log.Printf("inputs of sweepstake '%s' are unchanged", sweepstake.ID)
+			}
+			continue
+		}
+		build = append(build, sweepstake)
 	}
 
-	// write robots.txt
-	robots := "user-agent: *\ndisallow: *" // disallow all paths for all cralwers
-	if err = os.WriteFile(filepath.Join(siteDir, "robots.txt"), []byte(robots), 0644); err != nil {
-		log.Fatalf("cannot write robots.txt: %s", err.Error())
+	// compare markup with that of the previous build, unless writing an archive that must contain every file
+	var prevSite fs.FS
+	if archive == nil {
+		prevSite = os.DirFS(siteDir)
 	}
+	changed := mustDiffSweepstakeMarkup(prevSite, build, output)
 
-	// write index.html
-	if err = os.WriteFile(filepath.Join(siteDir, "index.html"), []byte(getIndexMarkup()), 0644); err != nil {
-		log.Fatalf("cannot write index.html: %s", err.Error())
+	// write markup for each sweepstake
+	for _, sweepstake := range build {
+		sweepstakeTimer := newTimer(nil)
+		mustWriteSweepstakeMarkup(site, sweepstake, output, changed[sweepstake.ID])
+		mustWriteSweepstakePrizes(site, sweepstake, prizesGeneratedAt, config.PrettyJSON)
+		if config.ParticipantPages {
+			mustWriteParticipantMarkup(site, sweepstake, output)
+		}
+		if config.Verbose {
+			log.Println(sweepstakeTimer.lap(fmt.Sprintf("generating markup for sweepstake '%s'", sweepstake.ID)))
+		}
+	}
+	log.Println(phaseTimer.lap("generating markup"))
+
+	// warn of problems that did not prevent markup from being generated
+	renderWarnings := domain.NewMultiError()
+	addRenderWarnings(build, renderWarnings)
+	if !renderWarnings.IsEmpty() {
+		log.Printf("warning: %s", renderWarnings.Error())
+	}
+
+	// write robots.txt, index.html, index.json and 404.html
+	if err = writeRootFiles(site, sweepstakes, siteURL, config.NotFoundPage, config.NotFoundMessage); err != nil {
+		log.Fatal(err)
+	}
+
+	// complete zip archive
+	if archive != nil {
+		if err = archive.close(); err != nil {
+			log.Fatalf("cannot write zip archive '%s': %s", config.OutputZip, err.Error())
+		}
+		if err = archiveFile.Close(); err != nil {
+			log.Fatalf("cannot write zip archive '%s': %s", config.OutputZip, err.Error())
+		}
+		log.Printf("wrote zip archive '%s'", config.OutputZip)
+	}
+
+	// write build state
+	if config.IncrementalBuild {
+		if err = state.save(config.BuildStatePath); err != nil {
+			log.Fatalf("cannot write build state: %s", err.Error())
+		}
+	}
+
+	// print plan
+	if *plan != "" {
+		diff, err := diffSites(os.DirFS(*plan), os.DirFS(siteDir))
+		if err != nil {
+			log.Fatalf("cannot compare against deployed site '%s': %s", *plan, err.Error())
+		}
+		fmt.Println(diff.summary())
+		if *failOnChange && !diff.isEmpty() {
+			os.RemoveAll(siteDir)
+			os.Exit(1)
+		}
+		return
 	}
 
 	// print status message
-	generated := len(sweepstakes) - skipped
+	generated := len(sweepstakes) - skipped - unchanged
+	if config.IncrementalBuild {
+		log.Printf("success! %d generated (%d skipped, %d unchanged)", generated, skipped, unchanged)
+		return
+	}
 	log.Printf("success! %d generated (%d skipped)", generated, skipped)
 }
 
-func mustLoadTournamentFromPath(ctx context.Context, path string) *domain.Tournament {
-	teamsLoader := (&domain.TeamsJSONLoader{}).
-		WithFileSystem(defaultFilesystem).
-		WithPath(filepath.Join(path, "teams.json"))
-
-	matchesLoader := (&domain.MatchesCSVLoader{}).
-		WithFileSystem(defaultFilesystem).
-		WithPath(filepath.Join(path, "matches.csv"))
-
-	tournament, err := (&domain.TournamentFSLoader{}).
-		WithFileSystem(defaultFilesystem).
-		WithTeamsLoader(teamsLoader).
-		WithMatchesLoader(matchesLoader).
-		WithConfigPath(filepath.Join(path, "tournament.json")).
-		WithMarkupPath(filepath.Join(path, "markup.gohtml")).
-		LoadTournament(ctx)
+func mustLoadTournamentFromPath(ctx context.Context, path string, warnings domain.MultiError) *domain.Tournament {
+	tournament, err := loadTournamentFromPath(ctx, defaultFilesystem, path, warnings)
 	if err != nil {
 		log.Fatalf("failed to load tournament from path '%s': %s", path, err.Error())
 	}
@@ -118,21 +360,757 @@ func mustLoadTournamentFromPath(ctx context.Context, path string) *domain.Tourna
 	return tournament
 }
 
-func mustWriteSweepstakeMarkup(sweepstake *domain.Sweepstake) {
-	b, err := sweepstake.GenerateMarkup()
+func loadTournamentFromPath(ctx context.Context, fSys fs.FS, path string, warnings domain.MultiError) (*domain.Tournament, error) {
+	loader := (&domain.TournamentBundleLoader{}).
+		WithFileSystem(fSys).
+		WithPath(path)
+
+	if warnings != nil {
+		loader.WithMissingImageWarnings(warnings.WithPrefix(path))
+	}
+
+	return loader.LoadTournament(ctx)
+}
+
+// addStaleMatchWarnings adds a warning to the provided collector for each match of the provided tournaments that is stale at the provided time,
+// so that an operator knows which results need to be entered (see domain.Tournament.HasStaleMatches)
+func addStaleMatchWarnings(tournaments domain.TournamentCollection, now time.Time, warnings domain.MultiError) {
+	for _, tournament := range tournaments {
+		warningsTournament := warnings.WithPrefix(fmt.Sprintf("tournament '%s'", tournament.ID))
+
+		for _, match := range tournament.HasStaleMatches(now) {
+			warningsTournament.Add(fmt.Errorf("match '%s' kicked off at %s but is not completed", match.ID, match.Timestamp.Format(time.RFC3339)))
+		}
+	}
+}
+
+// addRenderWarnings adds a warning to the provided collector for each problem that was encountered while rendering the provided sweepstakes,
+// but did not prevent them from being rendered (see domain.Sweepstake.RenderWarnings)
+func addRenderWarnings(sweepstakes domain.SweepstakeCollection, warnings domain.MultiError) {
+	for _, sweepstake := range sweepstakes {
+		warningsSweepstake := warnings.WithPrefix(fmt.Sprintf("sweepstake '%s'", sweepstake.ID))
+
+		for _, err := range sweepstake.RenderWarnings() {
+			warningsSweepstake.Add(err)
+		}
+	}
+}
+
+// translationsPath is the path of the optional file of label translations within the data directory
+const translationsPath = "translations.json"
+
+// loadTranslations returns the label translations within the provided file system, or nil if the file does not exist
+func loadTranslations(ctx context.Context, fSys fs.FS) (domain.Translations, error) {
+	if _, err := fs.Stat(fSys, translationsPath); errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+
+	return (&domain.TranslationsJSONLoader{}).
+		WithFileSystem(fSys).
+		WithPath(translationsPath).
+		LoadTranslations(ctx)
+}
+
+// validateConfig loads each tournament within the provided file system and the sweepstakes from the provided source,
+// then generates the markup of each sweepstake without writing it
+//
+// Every problem that is encountered is reported, rather than only the first. If warnings is not nil, each match that is stale
+// (see domain.Tournament.HasStaleMatches) is also reported within warnings, without failing validation
+func validateConfig(ctx context.Context, fSys fs.FS, sweepstakesSrc domain.BytesFunc, warnings domain.MultiError) domain.MultiError {
+	mErr := domain.NewMultiError()
+
+	tournaments := make(domain.TournamentCollection, 0)
+	if err := fs.WalkDir(fSys, "tournaments", func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.IsDir() || path == "tournaments" {
+			return nil
+		}
+
+		tournament, err := loadTournamentFromPath(ctx, fSys, path, nil)
+		if err != nil {
+			mErr.WithPrefix(path).Add(err)
+			return nil
+		}
+		tournaments = append(tournaments, tournament)
+
+		return nil
+	}); err != nil {
+		mErr.Add(err)
+		return mErr
+	}
+
+	if warnings != nil {
+		addStaleMatchWarnings(tournaments, time.Now(), warnings)
+	}
+
+	translations, err := loadTranslations(ctx, fSys)
+	if err != nil {
+		mErr.WithPrefix("translations").Add(err)
+		return mErr
+	}
+
+	sweepstakes, err := (&domain.SweepstakesJSONLoader{}).
+		WithSource(sweepstakesSrc).
+		WithTournamentCollection(tournaments).
+		WithSchemaValidation(true).
+		WithTranslations(translations).
+		LoadSweepstakes(ctx)
+	if err != nil {
+		mErr.WithPrefix("sweepstakes").Add(err)
+		return mErr
+	}
+
+	for _, sweepstake := range sweepstakes {
+		mErrSweepstake := mErr.WithPrefix(fmt.Sprintf("sweepstake '%s'", sweepstake.ID))
+
+		markup, err := sweepstake.GenerateMarkup()
+		switch {
+		case err != nil:
+			mErrSweepstake.Add(err)
+		case len(bytes.TrimSpace(markup)) == 0:
+			mErrSweepstake.Add(fmt.Errorf("markup: %w", domain.ErrIsEmpty))
+		}
+	}
+
+	return mErr
+}
+
+// mustDiffSweepstakeMarkup returns the ids of the provided sweepstakes whose encoded markup differs from that previously written to the
+// provided site, so that unchanged markup is not rewritten
+//
+// If prevSite is empty (nil), the markup of every sweepstake is considered to have changed
+func mustDiffSweepstakeMarkup(prevSite fs.FS, sweepstakes domain.SweepstakeCollection, output outputOptions) map[string]bool {
+	prev := make(map[string][]byte)
+	for _, sweepstake := range sweepstakes {
+		if prevSite == nil {
+			break
+		}
+		if b, err := fs.ReadFile(prevSite, path.Join(sweepstake.Slug(), output.filename())); err == nil {
+			prev[sweepstake.ID] = b
+		}
+	}
+
+	ids, err := sweepstakes.DiffEncodedMarkup(prev, output.encode)
+	if err != nil {
+		log.Fatalf("cannot compare markup: %s", err.Error())
+	}
+
+	changed := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		changed[id] = true
+	}
+
+	return changed
+}
+
+// mustWriteSweepstakeMarkup writes the markup of the provided sweepstake if changed is true, along with any mobile markup
+func mustWriteSweepstakeMarkup(site siteWriter, sweepstake *domain.Sweepstake, output outputOptions, changed bool) {
+	if !changed {
+		log.Printf("markup for sweepstake '%s' is unchanged", sweepstake.ID)
+	} else {
+		b, err := sweepstake.GenerateMarkup()
+		if err != nil {
+			log.Fatalf("cannot generate markup for sweepstake '%s': %s", sweepstake.ID, err.Error())
+		}
+
+		if _, err := site.write(path.Join(sweepstake.Slug(), output.filename()), output.encode(b)); err != nil {
+			log.Fatalf("cannot write markup for sweepstake '%s': %s", sweepstake.ID, err.Error())
+		}
+	}
+
+	// write mobile markup if the tournament provides it
+	if !sweepstake.Tournament.HasMarkupVariant(mobileVariant) {
+		return
+	}
+
+	b, err := sweepstake.GenerateMarkupVariant(mobileVariant)
 	if err != nil {
-		log.Fatalf("cannot generate markup for sweepstake '%s': %s", sweepstake.ID, err.Error())
+		log.Fatalf("cannot generate %s markup for sweepstake '%s': %s", mobileVariant, sweepstake.ID, err.Error())
 	}
 
-	sweepstakePath := filepath.Join(siteDir, sweepstake.ID)
-	if err := os.MkdirAll(sweepstakePath, 0755); err != nil {
-		log.Fatalf("cannot create directory '%s': %s", sweepstakePath, err.Error())
+	written, err := site.write(path.Join(sweepstake.Slug(), output.filenameFor(mobileVariant)), output.encode(b))
+	if err != nil {
+		log.Fatalf("cannot write %s markup for sweepstake '%s': %s", mobileVariant, sweepstake.ID, err.Error())
+	}
+	if !written {
+		log.Printf("%s markup for sweepstake '%s' is unchanged", mobileVariant, sweepstake.ID)
 	}
+}
 
-	markupPath := filepath.Join(sweepstakePath, "index.html")
-	if err := os.WriteFile(markupPath, b, 0644); err != nil {
-		log.Fatalf("cannot write markup for sweepstake '%s': %s", sweepstake.ID, err.Error())
+// mustWriteParticipantMarkup writes the page of each of the provided sweepstake's participants, if its tournament provides participant markup
+func mustWriteParticipantMarkup(site siteWriter, sweepstake *domain.Sweepstake, output outputOptions) {
+	if sweepstake.Tournament.ParticipantTemplate == nil {
+		return
 	}
+
+	for _, participant := range sweepstake.Participants {
+		slug := sweepstake.ParticipantSlug(participant)
+		if slug == "index" || slug == mobileVariant {
+			log.Fatalf("cannot write page for participant '%s' of sweepstake '%s': slug '%s' is reserved", participant.TeamID, sweepstake.ID, slug)
+		}
+
+		b, err := sweepstake.GenerateParticipantMarkup(participant)
+		if err != nil {
+			log.Fatalf("cannot generate page for participant '%s' of sweepstake '%s': %s", participant.TeamID, sweepstake.ID, err.Error())
+		}
+
+		if _, err := site.write(path.Join(sweepstake.Slug(), output.filenameFor(slug)), output.encode(b)); err != nil {
+			log.Fatalf("cannot write page for participant '%s' of sweepstake '%s': %s", participant.TeamID, sweepstake.ID, err.Error())
+		}
+	}
+}
+
+// writeIfChanged writes the provided bytes to the file at path, unless the file already exists with identical content
+//
+// Returns true if the file was written, so that unchanged files retain their modification time
+func writeIfChanged(path string, b []byte) (bool, error) {
+	change, err := compareFile(os.DirFS(filepath.Dir(path)), filepath.Base(path), b)
+	if err != nil || change == fileUnchanged {
+		return false, err
+	}
+
+	if err := os.WriteFile(path, b, 0644); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// fileChange describes how a file would change if new content were written to it
+type fileChange int
+
+const (
+	fileUnchanged fileChange = iota
+	fileAdded
+	fileChanged
+)
+
+// compareFile determines how the file at path within the provided filesystem would change if the provided bytes were written to it
+func compareFile(fSys fs.FS, path string, b []byte) (fileChange, error) {
+	existing, err := fs.ReadFile(fSys, path)
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+		return fileAdded, nil
+	case err != nil:
+		return fileUnchanged, err
+	case bytes.Equal(existing, b):
+		return fileUnchanged, nil
+	}
+
+	return fileChanged, nil
+}
+
+// writeRootFiles writes the files at the root of the site that are not specific to a sweepstake: robots.txt, index.html, index.json and,
+// if notFoundPage is true, 404.html with the provided message
+func writeRootFiles(site siteWriter, sweepstakes domain.SweepstakeCollection, baseURL string, notFoundPage bool, notFoundMessage string) error {
+	robots := "user-agent: *\ndisallow: *" // disallow all paths for all cralwers
+	if _, err := site.write("robots.txt", []byte(robots)); err != nil {
+		return fmt.Errorf("cannot write robots.txt: %w", err)
+	}
+
+	if _, err := site.write("index.html", []byte(getIndexMarkup())); err != nil {
+		return fmt.Errorf("cannot write index.html: %w", err)
+	}
+
+	index, err := sweepstakes.IndexJSON(baseURL)
+	if err != nil {
+		return fmt.Errorf("cannot generate index.json: %w", err)
+	}
+	if _, err = site.write("index.json", index); err != nil {
+		return fmt.Errorf("cannot write index.json: %w", err)
+	}
+
+	if !notFoundPage {
+		return nil
+	}
+
+	notFound, err := getNotFoundMarkup(notFoundMessage, baseURL)
+	if err != nil {
+		return fmt.Errorf("cannot generate 404.html: %w", err)
+	}
+	if _, err = site.write("404.html", []byte(notFound)); err != nil {
+		return fmt.Errorf("cannot write 404.html: %w", err)
+	}
+
+	return nil
+}
+
+// siteWriter writes each file of the generated site to its destination, at a slash-separated path relative to the root of the site
+type siteWriter interface {
+	// write writes the provided bytes to the file at the provided path, and returns true if the file was written rather than left unchanged
+	write(path string, b []byte) (bool, error)
+	// exists returns true if the file at the provided path was written by a previous build
+	exists(path string) bool
+}
+
+// dirWriter writes each file of the site within its root directory, leaving any file whose content is unchanged (see writeIfChanged)
+type dirWriter struct {
+	root string
+}
+
+func (d dirWriter) write(path string, b []byte) (bool, error) {
+	fullPath := filepath.Join(d.root, filepath.FromSlash(path))
+	if err := os.MkdirAll(filepath.Dir(fullPath), 0755); err != nil {
+		return false, fmt.Errorf("cannot create directory '%s': %w", filepath.Dir(fullPath), err)
+	}
+
+	return writeIfChanged(fullPath, b)
+}
+
+func (d dirWriter) exists(path string) bool {
+	_, err := os.Stat(filepath.Join(d.root, filepath.FromSlash(path)))
+	return err == nil
+}
+
+// zipWriter writes each file of the site to a zip archive, which is only complete once the writer is closed
+//
+// Entries share a fixed modification time, so that an identical site always produces an identical archive
+type zipWriter struct {
+	zw    *zip.Writer
+	paths map[string]struct{}
+}
+
+// zipModTime is the modification time of each entry of a zip archive (the earliest time that the format can represent)
+var zipModTime = time.Date(1980, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// newZipWriter returns a zipWriter that writes its archive to the provided writer
+func newZipWriter(w io.Writer) *zipWriter {
+	return &zipWriter{
+		zw:    zip.NewWriter(w),
+		paths: make(map[string]struct{}),
+	}
+}
+
+func (z *zipWriter) write(path string, b []byte) (bool, error) {
+	if _, ok := z.paths[path]; ok {
+		return false, fmt.Errorf("zip entry '%s': %w", path, domain.ErrIsDuplicate)
+	}
+	z.paths[path] = struct{}{}
+
+	f, err := z.zw.CreateHeader(&zip.FileHeader{Name: path, Method: zip.Deflate, Modified: zipModTime})
+	if err != nil {
+		return false, err
+	}
+
+	if _, err := f.Write(b); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// exists always returns false, since an archive never contains the files of a previous build
+func (z *zipWriter) exists(_ string) bool {
+	return false
+}
+
+// close completes the archive
+func (z *zipWriter) close() error {
+	return z.zw.Close()
+}
+
+// multiSiteWriter writes each file of the site with each of its writers
+type multiSiteWriter []siteWriter
+
+// write returns true if the file was written by any of the writers
+func (m multiSiteWriter) write(path string, b []byte) (bool, error) {
+	var written bool
+	for _, w := range m {
+		ok, err := w.write(path, b)
+		if err != nil {
+			return false, err
+		}
+		written = written || ok
+	}
+
+	return written, nil
+}
+
+// exists returns true if the file was written by a previous build of every writer
+func (m multiSiteWriter) exists(path string) bool {
+	for _, w := range m {
+		if !w.exists(path) {
+			return false
+		}
+	}
+
+	return len(m) > 0
+}
+
+// siteDiff represents the paths of the files that differ between a previously deployed site and a newly generated site
+type siteDiff struct {
+	added   []string // generated files that are not deployed
+	changed []string // generated files whose content differs from the deployed file
+	removed []string // deployed files that are no longer generated
+}
+
+// isEmpty returns true if the generated site is identical to the deployed site
+func (d siteDiff) isEmpty() bool {
+	return len(d.added) == 0 && len(d.changed) == 0 && len(d.removed) == 0
+}
+
+// summary returns each path of the diff prefixed by its change (+ added, ~ changed, - removed), followed by a count of each change
+func (d siteDiff) summary() string {
+	var sb strings.Builder
+
+	for _, change := range []struct {
+		prefix string
+		paths  []string
+	}{{"+", d.added}, {"~", d.changed}, {"-", d.removed}} {
+		for _, path := range change.paths {
+			sb.WriteString(change.prefix + " " + path + "\n")
+		}
+	}
+
+	sb.WriteString(fmt.Sprintf("plan: %d to add, %d to change, %d to remove", len(d.added), len(d.changed), len(d.removed)))
+
+	return sb.String()
+}
+
+// diffSites compares each file of the generated site with the file at the same path within the deployed site, in order of path
+//
+// A deployed site that does not exist is considered to be empty, so that every generated file is added
+func diffSites(deployed, generated fs.FS) (siteDiff, error) {
+	var diff siteDiff
+
+	generatedPaths := make(map[string]struct{})
+	if err := fs.WalkDir(generated, ".", func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		generatedPaths[path] = struct{}{}
+
+		b, err := fs.ReadFile(generated, path)
+		if err != nil {
+			return err
+		}
+
+		change, err := compareFile(deployed, path, b)
+		switch {
+		case err != nil:
+			return err
+		case change == fileAdded:
+			diff.added = append(diff.added, path)
+		case change == fileChanged:
+			diff.changed = append(diff.changed, path)
+		}
+
+		return nil
+	}); err != nil {
+		return siteDiff{}, err
+	}
+
+	if _, err := fs.Stat(deployed, "."); errors.Is(err, fs.ErrNotExist) {
+		return diff, nil
+	}
+
+	if err := fs.WalkDir(deployed, ".", func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+
+		if _, ok := generatedPaths[path]; !ok {
+			diff.removed = append(diff.removed, path)
+		}
+
+		return nil
+	}); err != nil {
+		return siteDiff{}, err
+	}
+
+	return diff, nil
+}
+
+// hasSweepstakeOutput returns true if every file that a build writes for the provided sweepstake has already been written
+//
+// This is its markup, any mobile markup, its prizes and, if participantPages is true, the page of each of its participants
+func hasSweepstakeOutput(site siteWriter, sweepstake *domain.Sweepstake, output outputOptions, participantPages bool) bool {
+	paths := []string{output.filename(), "prizes.json"}
+	if sweepstake.Tournament.HasMarkupVariant(mobileVariant) {
+		paths = append(paths, output.filenameFor(mobileVariant))
+	}
+	if participantPages && sweepstake.Tournament.ParticipantTemplate != nil {
+		for _, participant := range sweepstake.Participants {
+			paths = append(paths, output.filenameFor(sweepstake.ParticipantSlug(participant)))
+		}
+	}
+
+	for _, p := range paths {
+		if !site.exists(path.Join(sweepstake.Slug(), p)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func mustWriteSweepstakePrizes(site siteWriter, sweepstake *domain.Sweepstake, now time.Time, pretty bool) {
+	b, err := sweepstake.GeneratePrizeJSON(now, pretty)
+	if err != nil {
+		log.Fatalf("cannot generate prizes for sweepstake '%s': %s", sweepstake.ID, err.Error())
+	}
+
+	if _, err := site.write(path.Join(sweepstake.Slug(), "prizes.json"), b); err != nil {
+		log.Fatalf("cannot write prizes for sweepstake '%s': %s", sweepstake.ID, err.Error())
+	}
+}
+
+// defaultBuildStatePath is the path of the build state file, unless another path is provided
+const defaultBuildStatePath = ".build_state.json"
+
+// tournamentInputFiles defines the files within a tournament's directory that determine the markup of its sweepstakes
+var tournamentInputFiles = []string{"tournament.json", "teams.json", "matches.csv", "matches_updates.csv", "markup.gohtml", "markup_mobile.gohtml", "markup_participant.gohtml"}
+
+// buildState records a hash of the inputs of a build, so that a subsequent build can skip the sweepstakes whose inputs are unchanged
+type buildState struct {
+	Sweepstakes  string            `json:"sweepstakes"`            // hash of the sweepstakes source
+	Tournaments  map[string]string `json:"tournaments"`            // hash of the input files of each tournament, keyed by tournament id
+	Translations string            `json:"translations,omitempty"` // hash of the translations file, if any
+	Output       string            `json:"output"`                 // hash of the settings that determine the output, besides the inputs
+}
+
+// loadBuildState returns the build state that is stored at the provided path, or an empty state if the file does not exist
+func loadBuildState(path string) (buildState, error) {
+	var state buildState
+
+	b, err := os.ReadFile(path)
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+		return state, nil
+	case err != nil:
+		return state, err
+	}
+
+	if err := json.Unmarshal(b, &state); err != nil {
+		return state, fmt.Errorf("cannot unmarshal '%s': %w", path, err)
+	}
+
+	return state, nil
+}
+
+// save writes the build state to the provided path
+func (b buildState) save(path string) error {
+	data, err := json.MarshalIndent(b, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, data, 0644)
+}
+
+// isUnchanged returns true if the sweepstakes source, the translations, the output settings and the inputs of the provided tournament
+// are the same as those of the previous build
+func (b buildState) isUnchanged(prev buildState, tournamentID string) bool {
+	hash, ok := b.Tournaments[tournamentID]
+	if !ok || b.Sweepstakes == "" {
+		return false
+	}
+
+	return b.Sweepstakes == prev.Sweepstakes &&
+		b.Translations == prev.Translations &&
+		b.Output == prev.Output &&
+		hash == prev.Tournaments[tournamentID]
+}
+
+// outputSettings defines the settings that determine the files written by a build, besides its inputs
+type outputSettings struct {
+	CharsetMeta       bool
+	BOM               string
+	Extension         string
+	SiteURL           string
+	EnablePrizes      []string
+	ParticipantPages  bool
+	PrettyJSON        bool
+	PrizesGeneratedAt bool
+}
+
+// hash returns a hash of the output settings
+func (o outputSettings) hash() string {
+	return hashBytes([]byte(fmt.Sprintf("%#v", o)))
+}
+
+// hashTournamentInputs returns a hash of the input files within the provided tournament directory
+//
+// A file that does not exist (e.g. optional match updates) is hashed by name only, so that adding or removing it changes the hash
+func hashTournamentInputs(fSys fs.FS, path string) (string, error) {
+	h := sha256.New()
+
+	for _, name := range tournamentInputFiles {
+		b, err := fs.ReadFile(fSys, filepath.Join(path, name))
+		switch {
+		case errors.Is(err, fs.ErrNotExist):
+			fmt.Fprintf(h, "%s:-\n", name)
+			continue
+		case err != nil:
+			return "", err
+		}
+
+		// prefix each file with its name and length, so that content cannot shift between files without changing the hash
+		fmt.Fprintf(h, "%s:%d\n", name, len(b))
+		h.Write(b)
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// hashBytes returns a hash of the provided bytes
+func hashBytes(b []byte) string {
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:])
+}
+
+// bomMode determines how a utf-8 byte order mark is handled when writing output
+type bomMode string
+
+const (
+	bomAsIs  bomMode = ""      // write the generated bytes as-is (default)
+	bomStrip bomMode = "strip" // remove any leading byte order mark
+	bomKeep  bomMode = "keep"  // ensure exactly one leading byte order mark
+)
+
+var (
+	utf8BOM           = []byte("\xEF\xBB\xBF")
+	utf8CharsetMeta   = []byte(`<meta charset="UTF-8">` + "\n")
+	charsetMetaRx     = regexp.MustCompile(`(?i)<meta\s[^>]*charset\s*=`) // matches a meta tag that declares a charset, in any case
+	defaultExtension  = "html"
+	defaultOutputName = "index"
+	mobileVariant     = "mobile" // name of the markup variant that is written alongside the markup of a sweepstake whose tournament provides it
+)
+
+// withURLPathPrefix returns the provided base url followed by the provided path prefix (e.g. "/sweeps"), so that each link that is
+// generated relative to the result includes the prefix
+//
+// The prefix is normalised to a single leading slash without a trailing slash, so "sweeps", "/sweeps" and "/sweeps/" are equivalent.
+// An empty prefix returns the base url as-is, so that links are relative to the root
+func withURLPathPrefix(baseURL, prefix string) (string, error) {
+	trimmed := strings.Trim(prefix, "/ ")
+	if trimmed == "" {
+		return baseURL, nil
+	}
+
+	if strings.ContainsAny(trimmed, "?#:") || strings.Contains(trimmed, "//") {
+		return "", fmt.Errorf("invalid url path prefix: %s", prefix)
+	}
+
+	return strings.TrimRight(baseURL, "/") + "/" + trimmed, nil
+}
+
+// composeBasicAuth returns the basic auth credentials in the format "username:password"
+//
+// A separate username and password take precedence, so that either may contain a colon.
+// If both are empty, the provided combined credentials are returned as-is
+func composeBasicAuth(user, pass, combined string) string {
+	if user == "" && pass == "" {
+		return combined
+	}
+
+	return user + ":" + pass
+}
+
+// outputOptions determines how generated markup is encoded when it is written to disk
+type outputOptions struct {
+	charsetMeta bool    // prepend a utf-8 charset declaration
+	bom         bomMode // handling of a utf-8 byte order mark
+	extension   string  // extension of the output file, defaults to html
+}
+
+func (o outputOptions) validate() error {
+	switch o.bom {
+	case bomAsIs, bomStrip, bomKeep:
+		return nil
+	default:
+		return fmt.Errorf("invalid output bom mode: %s", o.bom)
+	}
+}
+
+// encode returns the provided markup encoded according to the options
+//
+// The zero value returns the markup as-is
+func (o outputOptions) encode(b []byte) []byte {
+	hasBOM := bytes.HasPrefix(b, utf8BOM)
+	body := bytes.TrimPrefix(b, utf8BOM)
+
+	if o.charsetMeta {
+		body = withCharsetMeta(body)
+	}
+
+	switch {
+	case o.bom == bomKeep, o.bom == bomAsIs && hasBOM:
+		return append(append([]byte{}, utf8BOM...), body...)
+	default:
+		return body
+	}
+}
+
+// withCharsetMeta returns the provided markup prepended by a utf-8 charset declaration
+//
+// If the markup begins with a doctype, the declaration is inserted after it so that the doctype remains first.
+// Markup that already declares a charset is returned as-is
+func withCharsetMeta(b []byte) []byte {
+	if charsetMetaRx.Match(b) {
+		return b
+	}
+
+	var doctype []byte
+
+	trimmed := bytes.TrimLeft(b, " \t\r\n")
+	if len(trimmed) >= len("<!doctype") && bytes.EqualFold(trimmed[:len("<!doctype")], []byte("<!doctype")) {
+		if idx := bytes.IndexByte(trimmed, '>'); idx >= 0 {
+			doctype = append(append([]byte{}, trimmed[:idx+1]...), '\n')
+			b = bytes.TrimLeft(trimmed[idx+1:], "\r\n")
+		}
+	}
+
+	encoded := append([]byte{}, doctype...)
+	encoded = append(encoded, utf8CharsetMeta...)
+
+	return append(encoded, b...)
+}
+
+// filename returns the name of the output file
+func (o outputOptions) filename() string {
+	return o.filenameFor(defaultOutputName)
+}
+
+// filenameFor returns the name of an output file with the provided base name (e.g. "mobile")
+func (o outputOptions) filenameFor(name string) string {
+	ext := strings.TrimPrefix(o.extension, ".")
+	if ext == "" {
+		ext = defaultExtension
+	}
+
+	return name + "." + ext
+}
+
+// timer measures the duration that elapses between each of its laps
+type timer struct {
+	now  func() time.Time
+	last time.Time
+}
+
+// newTimer returns a timer that starts immediately, using the provided function to obtain the current time
+//
+// If now is empty (nil), time.Now is used
+func newTimer(now func() time.Time) *timer {
+	if now == nil {
+		now = time.Now
+	}
+
+	return &timer{
+		now:  now,
+		last: now(),
+	}
+}
+
+// lap returns a summary of the duration elapsed since the previous lap (or the start of the timer) for the provided label
+func (t *timer) lap(label string) string {
+	now := t.now()
+	elapsed := now.Sub(t.last)
+	t.last = now
+
+	return fmt.Sprintf("%s took %s", label, elapsed.Round(time.Millisecond))
 }
 
 func getIndexMarkup() string {
@@ -153,3 +1131,48 @@ func getIndexMarkup() string {
 </html>
 `
 }
+
+// defaultNotFoundMessage is rendered by the 404 page, unless another message is provided
+const defaultNotFoundMessage = "Sorry, this page could not be found."
+
+var notFoundTemplate = template.Must(template.New("404").Parse(`<!DOCTYPE html>
+<html>
+	<head>
+		<title>Not Found</title>
+		<meta charset="UTF-8">
+		<style>
+			html{ font-size: 18px; }
+			body{ font-family: Comic Sans MS; }
+			h1{ font-size: 1.2rem; }
+		</style>
+	</head>
+	<body>
+		<h1>{{ .Message }}</h1>
+		<p><a href="{{ .IndexURL }}">Back to home</a></p>
+	</body>
+</html>
+`))
+
+// getNotFoundMarkup returns the markup of a 404 page that renders the provided message (or a default message if empty) and links to the index
+//
+// The index is relative to the provided base url, or root-relative if the base url is empty
+func getNotFoundMarkup(message, baseURL string) (string, error) {
+	if message == "" {
+		message = defaultNotFoundMessage
+	}
+
+	data := struct {
+		Message  string
+		IndexURL string
+	}{
+		Message:  message,
+		IndexURL: strings.TrimRight(baseURL, "/") + "/",
+	}
+
+	buf := &bytes.Buffer{}
+	if err := notFoundTemplate.Execute(buf, data); err != nil {
+		return "", err
+	}
+
+	return buf.String(), nil
+}
@@ -0,0 +1,1166 @@
+package main
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"errors"
+	"html/template"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/sweepstake-markup-generator/domain"
+)
+
+func TestTimer_Lap(t *testing.T) {
+	start := time.Date(2018, 5, 26, 14, 0, 0, 0, time.UTC)
+
+	// each call to now returns the next timestamp in sequence
+	timestamps := []time.Time{
+		start,
+		start.Add(1500 * time.Millisecond),
+		start.Add(1500*time.Millisecond + 250*time.Microsecond),
+		start.Add(2 * time.Minute),
+	}
+	now := func() time.Time {
+		ts := timestamps[0]
+		timestamps = timestamps[1:]
+		return ts
+	}
+
+	tmr := newTimer(now)
+
+	tt := []struct {
+		name    string
+		label   string
+		wantMsg string
+	}{
+		{
+			name:    "first lap must measure duration since timer started",
+			label:   "loading tournaments",
+			wantMsg: "loading tournaments took 1.5s",
+		},
+		{
+			name:    "sub-millisecond lap must be rounded to the nearest millisecond",
+			label:   "loading sweepstakes",
+			wantMsg: "loading sweepstakes took 0s",
+		},
+		{
+			name:    "subsequent lap must measure duration since previous lap",
+			label:   "generating markup",
+			wantMsg: "generating markup took 1m58.5s",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			gotMsg := tmr.lap(tc.label)
+			cmpDiff(t, tc.wantMsg, gotMsg)
+		})
+	}
+}
+
+func TestOutputOptions_Encode(t *testing.T) {
+	bom := "\xEF\xBB\xBF"
+
+	tt := []struct {
+		name   string
+		opts   outputOptions
+		markup string
+		want   string
+	}{
+		{
+			name:   "default options must write markup without bom as-is",
+			markup: "<p>hello</p>",
+			want:   "<p>hello</p>",
+		},
+		{
+			name:   "default options must write markup with bom as-is",
+			markup: bom + "<p>hello</p>",
+			want:   bom + "<p>hello</p>",
+		},
+		{
+			name:   "strip mode must remove leading bom",
+			opts:   outputOptions{bom: bomStrip},
+			markup: bom + "<p>hello</p>",
+			want:   "<p>hello</p>",
+		},
+		{
+			name:   "keep mode must add missing bom",
+			opts:   outputOptions{bom: bomKeep},
+			markup: "<p>hello</p>",
+			want:   bom + "<p>hello</p>",
+		},
+		{
+			name:   "keep mode must not duplicate existing bom",
+			opts:   outputOptions{bom: bomKeep},
+			markup: bom + "<p>hello</p>",
+			want:   bom + "<p>hello</p>",
+		},
+		{
+			name:   "charset meta must be prepended to markup without doctype",
+			opts:   outputOptions{charsetMeta: true},
+			markup: "<p>hello</p>",
+			want:   "<meta charset=\"UTF-8\">\n<p>hello</p>",
+		},
+		{
+			name:   "charset meta must be inserted after doctype and bom",
+			opts:   outputOptions{charsetMeta: true},
+			markup: bom + "<!DOCTYPE html>\n<p>hello</p>",
+			want:   bom + "<!DOCTYPE html>\n<meta charset=\"UTF-8\">\n<p>hello</p>",
+		},
+		{
+			name:   "charset meta must not be inserted into markup that already declares a charset",
+			opts:   outputOptions{charsetMeta: true},
+			markup: "<!DOCTYPE html>\n<head><META Charset=\"utf-8\"></head>",
+			want:   "<!DOCTYPE html>\n<head><META Charset=\"utf-8\"></head>",
+		},
+		{
+			name:   "charset meta must not be inserted into markup that declares a charset via http-equiv",
+			opts:   outputOptions{charsetMeta: true},
+			markup: "<meta http-equiv=\"Content-Type\" content=\"text/html; charset=utf-8\">",
+			want:   "<meta http-equiv=\"Content-Type\" content=\"text/html; charset=utf-8\">",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			got := tc.opts.encode([]byte(tc.markup))
+			cmpDiff(t, tc.want, string(got))
+		})
+	}
+}
+
+func TestComposeBasicAuth(t *testing.T) {
+	tt := []struct {
+		name     string
+		user     string
+		pass     string
+		combined string
+		want     string
+	}{
+		{
+			name: "no credentials must produce empty string",
+			want: "",
+		},
+		{
+			name:     "combined credentials must be used if no separate credentials are provided",
+			combined: "hello:world",
+			want:     "hello:world",
+		},
+		{
+			name: "separate credentials must be composed",
+			user: "hello",
+			pass: "world",
+			want: "hello:world",
+		},
+		{
+			name: "separate credentials must be composed if password contains a colon",
+			user: "hello",
+			pass: "wor:ld",
+			want: "hello:wor:ld",
+		},
+		{
+			name:     "separate credentials must take precedence over combined credentials",
+			user:     "hello",
+			pass:     "world",
+			combined: "goodbye:world",
+			want:     "hello:world",
+		},
+		{
+			name: "username without password must be composed",
+			user: "hello",
+			want: "hello:",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			cmpDiff(t, tc.want, composeBasicAuth(tc.user, tc.pass, tc.combined))
+		})
+	}
+}
+
+func TestOutputOptions_Filename(t *testing.T) {
+	tt := []struct {
+		name string
+		opts outputOptions
+		want string
+	}{
+		{
+			name: "default options must produce html filename",
+			want: "index.html",
+		},
+		{
+			name: "extension must be used with or without leading dot",
+			opts: outputOptions{extension: ".txt"},
+			want: "index.txt",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			cmpDiff(t, tc.want, tc.opts.filename())
+		})
+	}
+
+	t.Run("variant filename must use the provided name and extension", func(t *testing.T) {
+		cmpDiff(t, "mobile.txt", outputOptions{extension: "txt"}.filenameFor("mobile"))
+	})
+}
+
+func TestGetNotFoundMarkup(t *testing.T) {
+	tt := []struct {
+		name         string
+		message      string
+		baseURL      string
+		wantContains []string
+	}{
+		{
+			name: "default message must be rendered with root-relative link to index",
+			wantContains: []string{
+				"<h1>Sorry, this page could not be found.</h1>",
+				`<a href="/">Back to home</a>`,
+			},
+		},
+		{
+			name:    "custom message must be escaped and rendered with link to index relative to base url",
+			message: "Nothing to see <here>",
+			baseURL: "https://example.com/",
+			wantContains: []string{
+				"<h1>Nothing to see &lt;here&gt;</h1>",
+				`<a href="https://example.com/">Back to home</a>`,
+			},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			gotMarkup, gotErr := getNotFoundMarkup(tc.message, tc.baseURL)
+			if gotErr != nil {
+				t.Fatal(gotErr)
+			}
+
+			if !strings.HasPrefix(gotMarkup, "<!DOCTYPE html>") {
+				t.Fatalf("want markup with doctype, got: %s", gotMarkup)
+			}
+
+			for _, want := range tc.wantContains {
+				if !strings.Contains(gotMarkup, want) {
+					t.Fatalf("want markup to contain %s, got: %s", want, gotMarkup)
+				}
+			}
+		})
+	}
+}
+
+func TestWithURLPathPrefix(t *testing.T) {
+	tt := []struct {
+		name       string
+		baseURL    string
+		prefix     string
+		wantURL    string
+		wantErrMsg string
+	}{
+		{
+			name:    "empty prefix must return base url as-is",
+			baseURL: "https://example.com/",
+			wantURL: "https://example.com/",
+		},
+		{
+			name:    "empty prefix and base url must return empty url",
+			wantURL: "",
+		},
+		{
+			name:    "prefix must follow base url",
+			baseURL: "https://example.com/",
+			prefix:  "/sweeps/",
+			wantURL: "https://example.com/sweeps",
+		},
+		{
+			name:    "prefix without slashes must be root-relative without base url",
+			prefix:  "sweeps/2022",
+			wantURL: "/sweeps/2022",
+		},
+		{
+			name:    "prefix of only slashes must return base url as-is",
+			prefix:  "/",
+			wantURL: "",
+		},
+		{
+			name:       "prefix with query must produce the expected error",
+			prefix:     "/sweeps?page=1",
+			wantErrMsg: "invalid url path prefix: /sweeps?page=1",
+		},
+		{
+			name:       "prefix with scheme must produce the expected error",
+			prefix:     "https://example.com/sweeps",
+			wantErrMsg: "invalid url path prefix: https://example.com/sweeps",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			gotURL, gotErr := withURLPathPrefix(tc.baseURL, tc.prefix)
+			if tc.wantErrMsg != "" {
+				if gotErr == nil {
+					t.Fatalf("want error %s, got nil", tc.wantErrMsg)
+				}
+				cmpDiff(t, tc.wantErrMsg, gotErr.Error())
+				return
+			}
+			if gotErr != nil {
+				t.Fatal(gotErr)
+			}
+			cmpDiff(t, tc.wantURL, gotURL)
+		})
+	}
+}
+
+func TestWriteRootFiles_WithURLPathPrefix(t *testing.T) {
+	sweepstake := &domain.Sweepstake{
+		ID:         "Test Sweepstake 1",
+		Name:       "Test Sweepstake 1",
+		Tournament: &domain.Tournament{ID: "TestTourney1"},
+		Build:      true,
+	}
+
+	tt := []struct {
+		name         string
+		baseURL      string
+		prefix       string
+		wantIndexURL string
+		wantHomeLink string
+	}{
+		{
+			name:         "links without prefix must be root-relative",
+			wantIndexURL: `"url":"/test-sweepstake-1/"`,
+			wantHomeLink: `<a href="/">Back to home</a>`,
+		},
+		{
+			name:         "links with prefix must include prefix",
+			prefix:       "/sweeps/",
+			wantIndexURL: `"url":"/sweeps/test-sweepstake-1/"`,
+			wantHomeLink: `<a href="/sweeps/">Back to home</a>`,
+		},
+		{
+			name:         "links with base url and prefix must include both",
+			baseURL:      "https://example.com",
+			prefix:       "sweeps",
+			wantIndexURL: `"url":"https://example.com/sweeps/test-sweepstake-1/"`,
+			wantHomeLink: `<a href="https://example.com/sweeps/">Back to home</a>`,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			siteURL, err := withURLPathPrefix(tc.baseURL, tc.prefix)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			dir := t.TempDir()
+			if err := writeRootFiles(dirWriter{root: dir}, domain.SweepstakeCollection{sweepstake}, siteURL, true, ""); err != nil {
+				t.Fatal(err)
+			}
+
+			for path, want := range map[string]string{
+				"index.json": tc.wantIndexURL,
+				"404.html":   tc.wantHomeLink,
+			} {
+				got, err := os.ReadFile(filepath.Join(dir, path))
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !strings.Contains(string(got), want) {
+					t.Fatalf("want %s to contain %s, got: %s", path, want, got)
+				}
+			}
+		})
+	}
+}
+
+func TestMustWriteSweepstakeMarkup(t *testing.T) {
+	dir := t.TempDir()
+
+	tpl, err := template.New("tpl").Parse("<!DOCTYPE html>\n<h1>{{ .Title }}</h1>")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sweepstake := &domain.Sweepstake{
+		ID:         "Test Sweepstake 1",
+		Name:       "Test Sweepstake 1",
+		Tournament: &domain.Tournament{Template: tpl},
+	}
+
+	mustWriteSweepstakeMarkup(dirWriter{root: dir}, sweepstake, outputOptions{}, true)
+
+	got, err := os.ReadFile(filepath.Join(dir, "test-sweepstake-1", "index.html"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if bytes.HasPrefix(got, utf8BOM) {
+		t.Fatalf("want no bom, got %q", got)
+	}
+	cmpDiff(t, "<!DOCTYPE html>\n<h1>Test Sweepstake 1</h1>", string(got))
+}
+
+func TestMustDiffSweepstakeMarkup(t *testing.T) {
+	dir := t.TempDir()
+
+	tpl, err := template.New("tpl").Parse("<!DOCTYPE html>\n<h1>{{ .Title }}</h1>")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sweepstake := &domain.Sweepstake{
+		ID:         "Test Sweepstake 1",
+		Name:       "Test Sweepstake 1",
+		Tournament: &domain.Tournament{Template: tpl},
+	}
+	sweepstakes := domain.SweepstakeCollection{sweepstake}
+
+	// markup is written with a charset declaration, so it is only unchanged if it is compared once encoded
+	written := outputOptions{charsetMeta: true}
+	mustWriteSweepstakeMarkup(dirWriter{root: dir}, sweepstake, written, true)
+
+	cmpDiff(t, map[string]bool{}, mustDiffSweepstakeMarkup(os.DirFS(dir), sweepstakes, written))
+	cmpDiff(t, map[string]bool{"Test Sweepstake 1": true}, mustDiffSweepstakeMarkup(os.DirFS(dir), sweepstakes, outputOptions{}))
+	cmpDiff(t, map[string]bool{"Test Sweepstake 1": true}, mustDiffSweepstakeMarkup(nil, sweepstakes, written))
+}
+
+func TestZipWriter(t *testing.T) {
+	tpl, err := template.New("tpl").Parse("<h1>{{ .Title }}</h1>")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sweepstake := &domain.Sweepstake{
+		ID:         "Test Sweepstake 1",
+		Name:       "Test Sweepstake 1",
+		Tournament: &domain.Tournament{Template: tpl},
+		Build:      true,
+	}
+
+	// write site to both a directory and an archive
+	dir := t.TempDir()
+	buf := &bytes.Buffer{}
+	archive := newZipWriter(buf)
+	site := multiSiteWriter{dirWriter{root: dir}, archive}
+
+	mustWriteSweepstakeMarkup(site, sweepstake, outputOptions{}, true)
+	mustWriteSweepstakePrizes(site, sweepstake, time.Date(2018, 5, 26, 14, 0, 0, 0, time.UTC), false)
+	if err := writeRootFiles(site, domain.SweepstakeCollection{sweepstake}, "", true, ""); err != nil {
+		t.Fatal(err)
+	}
+	if err := archive.close(); err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var gotPaths []string
+	for _, f := range r.File {
+		gotPaths = append(gotPaths, f.Name)
+
+		// each entry must be identical to the file written to the directory
+		rc, err := f.Open()
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := io.ReadAll(rc)
+		rc.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		want, err := os.ReadFile(filepath.Join(dir, filepath.FromSlash(f.Name)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		cmpDiff(t, string(want), string(got))
+	}
+
+	cmpDiff(t, []string{
+		"test-sweepstake-1/index.html",
+		"test-sweepstake-1/prizes.json",
+		"robots.txt",
+		"index.html",
+		"index.json",
+		"404.html",
+	}, gotPaths)
+
+	// an archive never contains the files of a previous build
+	cmpDiff(t, false, site.exists("test-sweepstake-1/index.html"))
+	cmpDiff(t, true, dirWriter{root: dir}.exists("test-sweepstake-1/index.html"))
+
+	// a path cannot be written to the archive more than once
+	dupe := newZipWriter(&bytes.Buffer{})
+	if _, err := dupe.write("index.html", nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := dupe.write("index.html", nil); !errors.Is(err, domain.ErrIsDuplicate) {
+		t.Fatalf("want error %s, got %v", domain.ErrIsDuplicate, err)
+	}
+}
+
+func TestWriteIfChanged(t *testing.T) {
+	dir := t.TempDir()
+	past := time.Date(2018, 5, 26, 14, 0, 0, 0, time.UTC)
+
+	// writeFixture writes the provided content to a file whose modification time is in the past
+	writeFixture := func(t *testing.T, name, content string) string {
+		t.Helper()
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.Chtimes(path, past, past); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+
+	tt := []struct {
+		name        string
+		path        string
+		content     string
+		wantWritten bool
+		wantModTime bool // true if modification time must be retained
+	}{
+		{
+			name:        "changed file must be written",
+			path:        writeFixture(t, "changed.html", "<h1>previous</h1>"),
+			content:     "<h1>current</h1>",
+			wantWritten: true,
+		},
+		{
+			name:        "unchanged file must not be written",
+			path:        writeFixture(t, "unchanged.html", "<h1>current</h1>"),
+			content:     "<h1>current</h1>",
+			wantWritten: false,
+			wantModTime: true,
+		},
+		{
+			name:        "missing file must be written",
+			path:        filepath.Join(dir, "missing.html"),
+			content:     "<h1>current</h1>",
+			wantWritten: true,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			gotWritten, err := writeIfChanged(tc.path, []byte(tc.content))
+			if err != nil {
+				t.Fatal(err)
+			}
+			cmpDiff(t, tc.wantWritten, gotWritten)
+
+			got, err := os.ReadFile(tc.path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			cmpDiff(t, tc.content, string(got))
+
+			info, err := os.Stat(tc.path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			cmpDiff(t, tc.wantModTime, info.ModTime().Equal(past))
+		})
+	}
+}
+
+func TestDiffSites(t *testing.T) {
+	generated := fstest.MapFS{
+		"index.html":              {Data: []byte("<h1>index</h1>")},
+		"example/index.html":      {Data: []byte("<h1>current</h1>")},
+		"example/prizes.json":     {Data: []byte(`{"prizes":[]}`)},
+		"example/marc-pugh.html":  {Data: []byte("<h1>Marc Pugh</h1>")},
+		"new-example/index.html":  {Data: []byte("<h1>new</h1>")},
+		"new-example/prizes.json": {Data: []byte(`{"prizes":[]}`)},
+	}
+
+	tt := []struct {
+		name     string
+		deployed fs.FS
+		wantDiff siteDiff
+	}{
+		{
+			name: "added, changed and removed files must be listed in order of path",
+			deployed: fstest.MapFS{
+				"index.html":                  {Data: []byte("<h1>index</h1>")},
+				"example/index.html":          {Data: []byte("<h1>previous</h1>")},
+				"example/prizes.json":         {Data: []byte(`{"prizes":[]}`)},
+				"example/steve-fletcher.html": {Data: []byte("<h1>Steve Fletcher</h1>")},
+				"old-example/index.html":      {Data: []byte("<h1>old</h1>")},
+			},
+			wantDiff: siteDiff{
+				added:   []string{"example/marc-pugh.html", "new-example/index.html", "new-example/prizes.json"},
+				changed: []string{"example/index.html"},
+				removed: []string{"example/steve-fletcher.html", "old-example/index.html"},
+			},
+		},
+		{
+			name:     "identical site must produce an empty diff",
+			deployed: generated,
+			wantDiff: siteDiff{},
+		},
+		{
+			name:     "deployed site that does not exist must add every generated file",
+			deployed: os.DirFS(filepath.Join(t.TempDir(), "non-existent")),
+			wantDiff: siteDiff{
+				added: []string{
+					"example/index.html",
+					"example/marc-pugh.html",
+					"example/prizes.json",
+					"index.html",
+					"new-example/index.html",
+					"new-example/prizes.json",
+				},
+			},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			gotDiff, err := diffSites(tc.deployed, generated)
+			if err != nil {
+				t.Fatal(err)
+			}
+			cmpDiff(t, tc.wantDiff.added, gotDiff.added)
+			cmpDiff(t, tc.wantDiff.changed, gotDiff.changed)
+			cmpDiff(t, tc.wantDiff.removed, gotDiff.removed)
+			cmpDiff(t, len(tc.wantDiff.added)+len(tc.wantDiff.changed)+len(tc.wantDiff.removed) == 0, gotDiff.isEmpty())
+		})
+	}
+}
+
+func TestSiteDiff_Summary(t *testing.T) {
+	diff := siteDiff{
+		added:   []string{"new-example/index.html"},
+		changed: []string{"example/index.html", "example/prizes.json"},
+		removed: []string{"old-example/index.html"},
+	}
+
+	want := "+ new-example/index.html\n" +
+		"~ example/index.html\n" +
+		"~ example/prizes.json\n" +
+		"- old-example/index.html\n" +
+		"plan: 1 to add, 2 to change, 1 to remove"
+	cmpDiff(t, want, diff.summary())
+
+	cmpDiff(t, "plan: 0 to add, 0 to change, 0 to remove", siteDiff{}.summary())
+}
+
+func TestHashTournamentInputs(t *testing.T) {
+	baseFiles := fstest.MapFS{
+		"tournaments/a/tournament.json": {Data: []byte(`{"id": "TestTourney1"}`)},
+		"tournaments/a/teams.json":      {Data: []byte(`{"teams": []}`)},
+		"tournaments/a/matches.csv":     {Data: []byte("MATCH_ID\n")},
+		"tournaments/a/markup.gohtml":   {Data: []byte("<h1>{{ .Title }}</h1>")},
+	}
+
+	// withFiles returns a copy of the base files with the provided files added or replaced
+	withFiles := func(files map[string]string) fstest.MapFS {
+		fSys := make(fstest.MapFS)
+		for path, file := range baseFiles {
+			fSys[path] = file
+		}
+		for path, data := range files {
+			fSys[path] = &fstest.MapFile{Data: []byte(data)}
+		}
+		return fSys
+	}
+
+	baseHash, err := hashTournamentInputs(baseFiles, "tournaments/a")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tt := []struct {
+		name        string
+		fSys        fstest.MapFS
+		wantChanged bool
+	}{
+		{
+			name: "unchanged inputs must produce the same hash",
+			fSys: withFiles(nil),
+		},
+		{
+			name: "change to an unrelated file must produce the same hash",
+			fSys: withFiles(map[string]string{"tournaments/a/README.md": "notes", "tournaments/b/matches.csv": "MATCH_ID\nF\n"}),
+		},
+		{
+			name:        "changed config must produce a different hash",
+			fSys:        withFiles(map[string]string{"tournaments/a/tournament.json": `{"id": "TestTourney2"}`}),
+			wantChanged: true,
+		},
+		{
+			name:        "changed teams must produce a different hash",
+			fSys:        withFiles(map[string]string{"tournaments/a/teams.json": `{"teams": [{"id": "PTFC"}]}`}),
+			wantChanged: true,
+		},
+		{
+			name:        "changed matches must produce a different hash",
+			fSys:        withFiles(map[string]string{"tournaments/a/matches.csv": "MATCH_ID\nF\n"}),
+			wantChanged: true,
+		},
+		{
+			name:        "added match updates must produce a different hash",
+			fSys:        withFiles(map[string]string{"tournaments/a/matches_updates.csv": "MATCH_ID\n"}),
+			wantChanged: true,
+		},
+		{
+			name:        "changed markup must produce a different hash",
+			fSys:        withFiles(map[string]string{"tournaments/a/markup.gohtml": "<h2>{{ .Title }}</h2>"}),
+			wantChanged: true,
+		},
+		{
+			name:        "added participant markup must produce a different hash",
+			fSys:        withFiles(map[string]string{"tournaments/a/markup_participant.gohtml": "<p>{{ .Title }}</p>"}),
+			wantChanged: true,
+		},
+		{
+			name:        "added mobile markup must produce a different hash",
+			fSys:        withFiles(map[string]string{"tournaments/a/markup_mobile.gohtml": "<p>{{ .Title }}</p>"}),
+			wantChanged: true,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			gotHash, err := hashTournamentInputs(tc.fSys, "tournaments/a")
+			if err != nil {
+				t.Fatal(err)
+			}
+			cmpDiff(t, tc.wantChanged, gotHash != baseHash)
+		})
+	}
+}
+
+func TestBuildState_IsUnchanged(t *testing.T) {
+	prev := buildState{
+		Sweepstakes: "sweepstakes-hash",
+		Tournaments: map[string]string{"TestTourney1": "tourney-1-hash", "TestTourney2": "tourney-2-hash"},
+		Output:      "output-hash",
+	}
+
+	tt := []struct {
+		name          string
+		state         buildState
+		tournamentID  string
+		wantUnchanged bool
+	}{
+		{
+			name: "unchanged inputs must be unchanged",
+			state: buildState{
+				Sweepstakes: "sweepstakes-hash",
+				Tournaments: map[string]string{"TestTourney1": "tourney-1-hash", "TestTourney2": "tourney-2-changed"},
+				Output:      "output-hash",
+			},
+			tournamentID:  "TestTourney1",
+			wantUnchanged: true,
+		},
+		{
+			name: "changed tournament inputs must be changed",
+			state: buildState{
+				Sweepstakes: "sweepstakes-hash",
+				Tournaments: map[string]string{"TestTourney1": "tourney-1-hash", "TestTourney2": "tourney-2-changed"},
+			},
+			tournamentID: "TestTourney2",
+		},
+		{
+			name: "changed sweepstakes source must be changed",
+			state: buildState{
+				Sweepstakes: "sweepstakes-changed",
+				Tournaments: map[string]string{"TestTourney1": "tourney-1-hash"},
+			},
+			tournamentID: "TestTourney1",
+		},
+		{
+			name: "changed translations must be changed",
+			state: buildState{
+				Sweepstakes:  "sweepstakes-hash",
+				Tournaments:  map[string]string{"TestTourney1": "tourney-1-hash"},
+				Translations: "translations-hash",
+			},
+			tournamentID: "TestTourney1",
+		},
+		{
+			name: "changed output settings must be changed",
+			state: buildState{
+				Sweepstakes: "sweepstakes-hash",
+				Tournaments: map[string]string{"TestTourney1": "tourney-1-hash"},
+				Output:      "output-changed",
+			},
+			tournamentID: "TestTourney1",
+		},
+		{
+			name: "tournament that is new since the previous build must be changed",
+			state: buildState{
+				Sweepstakes: "sweepstakes-hash",
+				Tournaments: map[string]string{"TestTourney3": "tourney-3-hash"},
+			},
+			tournamentID: "TestTourney3",
+		},
+		{
+			name: "tournament that has not been hashed must be changed",
+			state: buildState{
+				Sweepstakes: "sweepstakes-hash",
+			},
+			tournamentID: "TestTourney1",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			cmpDiff(t, tc.wantUnchanged, tc.state.isUnchanged(prev, tc.tournamentID))
+		})
+	}
+
+	t.Run("empty previous state must be changed", func(t *testing.T) {
+		cmpDiff(t, false, prev.isUnchanged(buildState{}, "TestTourney1"))
+	})
+}
+
+func TestOutputSettings_Hash(t *testing.T) {
+	base := outputSettings{Extension: ".html", SiteURL: "https://example.com/"}
+	baseHash := base.hash()
+
+	cmpDiff(t, baseHash, base.hash())
+
+	tt := []struct {
+		name     string
+		settings outputSettings
+	}{
+		{name: "charset meta", settings: outputSettings{CharsetMeta: true, Extension: ".html", SiteURL: "https://example.com/"}},
+		{name: "bom", settings: outputSettings{BOM: "keep", Extension: ".html", SiteURL: "https://example.com/"}},
+		{name: "extension", settings: outputSettings{Extension: ".htm", SiteURL: "https://example.com/"}},
+		{name: "site url", settings: outputSettings{Extension: ".html", SiteURL: "https://example.com/sweepstakes/"}},
+		{name: "enabled prizes", settings: outputSettings{Extension: ".html", SiteURL: "https://example.com/", EnablePrizes: []string{"winner"}}},
+		{name: "participant pages", settings: outputSettings{Extension: ".html", SiteURL: "https://example.com/", ParticipantPages: true}},
+		{name: "pretty json", settings: outputSettings{Extension: ".html", SiteURL: "https://example.com/", PrettyJSON: true}},
+		{name: "prizes generated at", settings: outputSettings{Extension: ".html", SiteURL: "https://example.com/", PrizesGeneratedAt: true}},
+	}
+
+	for _, tc := range tt {
+		t.Run("changed "+tc.name+" must produce a different hash", func(t *testing.T) {
+			if tc.settings.hash() == baseHash {
+				t.Fatalf("want hash to differ from %s", baseHash)
+			}
+		})
+	}
+}
+
+func TestHasSweepstakeOutput(t *testing.T) {
+	tpl, err := template.New("tpl").Parse("<h1>{{ .Title }}</h1>")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sweepstake := &domain.Sweepstake{
+		ID:   "Test Sweepstake 1",
+		Name: "Test Sweepstake 1",
+		Tournament: &domain.Tournament{
+			Template:            tpl,
+			ParticipantTemplate: tpl,
+			Variants:            map[string]*template.Template{mobileVariant: tpl},
+		},
+		Participants: []*domain.Participant{{TeamID: "ABC", Name: "John Smith"}},
+	}
+
+	// withFiles returns a site that contains the provided files of the sweepstake
+	withFiles := func(names ...string) siteWriter {
+		dir := t.TempDir()
+		if err := os.MkdirAll(filepath.Join(dir, "test-sweepstake-1"), 0755); err != nil {
+			t.Fatal(err)
+		}
+		for _, name := range names {
+			if err := os.WriteFile(filepath.Join(dir, "test-sweepstake-1", name), nil, 0644); err != nil {
+				t.Fatal(err)
+			}
+		}
+		return dirWriter{root: dir}
+	}
+
+	tt := []struct {
+		name             string
+		site             siteWriter
+		participantPages bool
+		want             bool
+	}{
+		{
+			name:             "every file must have output",
+			site:             withFiles("index.html", "mobile.html", "prizes.json", "john-smith.html"),
+			participantPages: true,
+			want:             true,
+		},
+		{
+			name: "participant pages that are not enabled must not be required",
+			site: withFiles("index.html", "mobile.html", "prizes.json"),
+			want: true,
+		},
+		{
+			name:             "missing participant page must not have output",
+			site:             withFiles("index.html", "mobile.html", "prizes.json"),
+			participantPages: true,
+		},
+		{
+			name: "missing mobile markup must not have output",
+			site: withFiles("index.html", "prizes.json"),
+		},
+		{
+			name: "missing prizes must not have output",
+			site: withFiles("index.html", "mobile.html"),
+		},
+		{
+			name: "missing markup must not have output",
+			site: withFiles("mobile.html", "prizes.json"),
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			cmpDiff(t, tc.want, hasSweepstakeOutput(tc.site, sweepstake, outputOptions{}, tc.participantPages))
+		})
+	}
+}
+
+func TestLoadBuildState(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "state.json")
+
+	t.Run("missing file must return an empty state", func(t *testing.T) {
+		got, err := loadBuildState(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		cmpDiff(t, buildState{}, got)
+	})
+
+	t.Run("saved state must be loaded", func(t *testing.T) {
+		want := buildState{
+			Sweepstakes: "sweepstakes-hash",
+			Tournaments: map[string]string{"TestTourney1": "tourney-1-hash"},
+		}
+		if err := want.save(path); err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := loadBuildState(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		cmpDiff(t, want, got)
+	})
+}
+
+func TestValidateConfig(t *testing.T) {
+	// newTournamentFiles returns the files of a tournament with two teams and one match, using the provided config and markup
+	newTournamentFiles := func(dir, config, markup string) fstest.MapFS {
+		return fstest.MapFS{
+			dir + "/tournament.json": {Data: []byte(config)},
+			dir + "/markup.gohtml":   {Data: []byte(markup)},
+			dir + "/teams.json": {Data: []byte(`{"teams": [` +
+				`{"id": "PTFC", "name": "Poole Town", "image_url": "http://ptfc.jpg"},` +
+				`{"id": "STHFC", "name": "Sholing", "image_url": "http://sthfc.jpg"}` +
+				`]}`)},
+			dir + "/matches.csv": {Data: []byte("MATCH_ID,DATE,TIME,STAGE,COMPLETED,WINNER_TEAM_ID,HOME_TEAM_ID,AWAY_TEAM_ID,HOME_GOALS,AWAY_GOALS," +
+				"HOME_YELLOW_CARDS,AWAY_YELLOW_CARDS,HOME_OG,AWAY_OG,HOME_RED_CARDS,AWAY_RED_CARDS\n" +
+				"F,26/05/2018,14:00,KO,Y,PTFC,STHFC,PTFC,1,2,0,0,,,,\n")},
+		}
+	}
+
+	// mergeFiles returns a single file system comprising each of the provided file systems
+	mergeFiles := func(fSyss ...fstest.MapFS) fstest.MapFS {
+		merged := make(fstest.MapFS)
+		for _, fSys := range fSyss {
+			for path, file := range fSys {
+				merged[path] = file
+			}
+		}
+		return merged
+	}
+
+	okConfig := `{"id": "TestTourney1", "name": "Test Tournament 1", "image_url": "http://tourney.jpg"}`
+	okTournament := newTournamentFiles("tournaments/ok", okConfig, "<h1>{{ .Title }}</h1>")
+
+	newSweepstakes := func(tournamentID string) domain.BytesFunc {
+		return func() ([]byte, error) {
+			return []byte(`{"sweepstakes": [{"id": "test-sweepstake-1", "name": "Test Sweepstake 1", "tournament_id": "` + tournamentID + `",` +
+				`"participants": [{"team_id": "PTFC", "participant_name": "Jon L"}, {"team_id": "STHFC", "participant_name": "Paul C"}]}]}`), nil
+		}
+	}
+
+	newSweepstakesWithLang := func(lang string) domain.BytesFunc {
+		return func() ([]byte, error) {
+			return []byte(`{"sweepstakes": [{"id": "test-sweepstake-1", "name": "Test Sweepstake 1", "tournament_id": "TestTourney1", "lang": "` + lang + `",` +
+				`"participants": [{"team_id": "PTFC", "participant_name": "Jon L"}, {"team_id": "STHFC", "participant_name": "Paul C"}]}]}`), nil
+		}
+	}
+
+	tt := []struct {
+		name        string
+		fSys        fstest.MapFS
+		sweepstakes domain.BytesFunc
+		wantMsg     string
+	}{
+		{
+			name:        "valid config must produce no errors",
+			fSys:        okTournament,
+			sweepstakes: newSweepstakes("TestTourney1"),
+			wantMsg:     "0 errors",
+		},
+		{
+			name: "invalid tournament and markup that renders nothing must both be reported",
+			fSys: mergeFiles(
+				newTournamentFiles("tournaments/empty-markup", okConfig, "{{ if false }}<h1>{{ .Title }}</h1>{{ end }}"),
+				newTournamentFiles("tournaments/invalid", `{"id": "TestTourney2", "image_url": "http://tourney.jpg"}`, "<h1>{{ .Title }}</h1>"),
+			),
+			sweepstakes: newSweepstakes("TestTourney1"),
+			wantMsg: "2 errors:\n" +
+				"- tournaments/invalid: 1 error:\n- name: is empty\n" +
+				"- sweepstake 'test-sweepstake-1': markup: is empty",
+		},
+		{
+			name: "sweepstake with translated language must produce no errors",
+			fSys: mergeFiles(okTournament, fstest.MapFS{
+				"translations.json": {Data: []byte(`{"translations": {"fr": {"TBC": "À confirmer"}}}`)},
+			}),
+			sweepstakes: newSweepstakesWithLang("fr"),
+			wantMsg:     "0 errors",
+		},
+		{
+			name:        "sweepstake with language that has no translations must be reported",
+			fSys:        okTournament,
+			sweepstakes: newSweepstakesWithLang("fr"),
+			wantMsg:     "1 error:\n- sweepstakes: 1 error:\n- lang 'fr': not found",
+		},
+		{
+			name: "invalid translations must be reported",
+			fSys: mergeFiles(okTournament, fstest.MapFS{
+				"translations.json": {Data: []byte(`{"translations": {"fr": {"TBC": ""}}}`)},
+			}),
+			sweepstakes: newSweepstakes("TestTourney1"),
+			wantMsg:     "1 error:\n- translations: 1 error:\n- lang 'fr': label 'TBC': translation: is empty",
+		},
+		{
+			name:        "sweepstake with unknown tournament must be reported",
+			fSys:        okTournament,
+			sweepstakes: newSweepstakes("TestTourney2"),
+			wantMsg:     "1 error:\n- sweepstakes: sweepstake index 0: tournament id 'TestTourney2': not found",
+		},
+		{
+			name: "sweepstake that violates the schema must be reported by path",
+			fSys: okTournament,
+			sweepstakes: func() ([]byte, error) {
+				return []byte(`{"sweepstakes": [{"id": "test-sweepstake-1", "tournament_id": "TestTourney1", "participants": []}]}`), nil
+			},
+			wantMsg: "1 error:\n- sweepstakes: 1 error:\n- sweepstakes[0].name: is required",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			gotErr := validateConfig(context.Background(), tc.fSys, tc.sweepstakes, nil)
+			cmpDiff(t, tc.wantMsg, gotErr.Error())
+		})
+	}
+}
+
+func TestAddStaleMatchWarnings(t *testing.T) {
+	now := time.Date(2018, 5, 27, 18, 0, 0, 0, time.UTC)
+
+	tournaments := domain.TournamentCollection{
+		{
+			ID: "TestTourney1",
+			Matches: domain.MatchCollection{
+				{ID: "A1", Timestamp: time.Date(2018, 5, 26, 14, 0, 0, 0, time.UTC)},                   // stale
+				{ID: "A2", Timestamp: time.Date(2018, 5, 26, 19, 45, 0, 0, time.UTC), Completed: true}, // completed
+				{ID: "A3", Timestamp: time.Date(2018, 5, 27, 17, 0, 0, 0, time.UTC)},                   // in progress
+			},
+		},
+		{
+			ID: "TestTourney2",
+			Matches: domain.MatchCollection{
+				{ID: "B1", Timestamp: time.Date(2018, 5, 27, 14, 0, 0, 0, time.UTC)}, // stale
+			},
+		},
+	}
+
+	warnings := domain.NewMultiError()
+	addStaleMatchWarnings(tournaments, now, warnings)
+
+	cmpDiff(t, "2 errors:\n"+
+		"- tournament 'TestTourney1': match 'A1' kicked off at 2018-05-26T14:00:00Z but is not completed\n"+
+		"- tournament 'TestTourney2': match 'B1' kicked off at 2018-05-27T14:00:00Z but is not completed", warnings.Error())
+}
+
+func TestAddRenderWarnings(t *testing.T) {
+	tournament := &domain.Tournament{
+		Teams: domain.TeamCollection{
+			{ID: "ABC", Name: "Team ABC"},
+		},
+		Matches: domain.MatchCollection{
+			{
+				Completed: true,
+				Home:      domain.MatchCompetitor{Team: &domain.Team{ID: "ABC", Name: "Team ABC"}},
+				Away:      domain.MatchCompetitor{Team: &domain.Team{ID: "DEF", Name: "Team DEF"}, Goals: 1},
+			},
+		},
+		ValueTemplates: map[string]string{
+			"most_goals_conceded": "{{ .NotAField }}",
+		},
+	}
+
+	sweepstakes := domain.SweepstakeCollection{
+		{
+			ID:           "TestSweepstake1",
+			Tournament:   tournament,
+			Participants: domain.ParticipantCollection{{TeamID: "ABC", Name: "Participant ABC"}},
+			Prizes:       domain.PrizeSettings{MostGoalsConceded: true},
+		},
+		{
+			ID:           "TestSweepstake2",
+			Tournament:   tournament,
+			Participants: domain.ParticipantCollection{{TeamID: "ABC", Name: "Participant ABC"}},
+			// no prizes
+		},
+	}
+
+	for _, sweepstake := range sweepstakes {
+		// render each sweepstake twice, so that a repeated problem is only reported once
+		for i := 0; i < 2; i++ {
+			if _, err := sweepstake.GeneratePrizeText(); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	warnings := domain.NewMultiError()
+	addRenderWarnings(sweepstakes, warnings)
+
+	cmpDiff(t, "1 error:\n"+
+		"- sweepstake 'TestSweepstake1': value template 'most_goals_conceded': cannot execute: "+
+		`template: most_goals_conceded:1:3: executing "most_goals_conceded" at <.NotAField>: can't evaluate field NotAField in type domain.RankValue`, warnings.Error())
+}
+
+func cmpDiff(t *testing.T, want, got interface{}) {
+	t.Helper()
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("mismatch (-want, +got): %s", diff)
+	}
+}